@@ -0,0 +1,20 @@
+# Write import blocks for every production http monitor
+data "cronitor_monitor_imports" "production" {
+  tags = ["production"]
+}
+
+resource "local_file" "imports" {
+  filename = "imports.tf"
+  content = join("\n", [
+    for m in data.cronitor_monitor_imports.production.monitors : <<-EOT
+    import {
+      to = ${m.resource_type}.${m.resource_name}
+      id = "${m.key}"
+    }
+    EOT
+    if m.resource_type == "cronitor_http_monitor"
+  ])
+}
+
+# Then generate their configuration with
+# terraform plan -generate-config-out=generated.tf
@@ -1,3 +1,7 @@
 data "cronitor_notification_list" "this" {
   key = "default"
 }
+
+data "cronitor_notification_list" "payments" {
+  name = "Payments on-call"
+}
@@ -0,0 +1,8 @@
+# Find the lists that still page someone who has left
+data "cronitor_notification_lists" "leaver" {
+  email = "jane@example.com"
+}
+
+output "lists_paging_leaver" {
+  value = data.cronitor_notification_lists.leaver.lists[*].name
+}
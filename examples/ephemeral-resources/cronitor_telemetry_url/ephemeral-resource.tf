@@ -0,0 +1,12 @@
+ephemeral "cronitor_telemetry_url" "backup" {
+  monitor = cronitor_heartbeat_monitor.backup.key
+  env     = "production"
+}
+
+# Hand the url to the job without it being stored in either state
+resource "aws_ssm_parameter" "backup_ping_url" {
+  name             = "/backup/cronitor-url"
+  type             = "SecureString"
+  value_wo         = ephemeral.cronitor_telemetry_url.backup.url
+  value_wo_version = 1
+}
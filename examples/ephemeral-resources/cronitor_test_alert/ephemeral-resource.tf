@@ -0,0 +1,5 @@
+# Check the new list reaches everyone by sending a test alert during the run
+ephemeral "cronitor_test_alert" "oncall" {
+  notification_list = cronitor_notification_list.oncall.key
+  monitor           = cronitor_http_monitor.api.key
+}
@@ -0,0 +1,13 @@
+resource "cronitor_http_monitor" "this" {
+  name     = "API"
+  schedule = "every 5 minutes"
+  assertions = [
+    provider::cronitor::json_assertion("data.status", "=", "ok"),
+    provider::cronitor::json_assertion("data.queue_depth", "<", "100"),
+  ]
+
+  request {
+    url    = "https://api.example.com/health"
+    method = "GET"
+  }
+}
@@ -0,0 +1,11 @@
+locals {
+  jobs = ["Nightly Backup (EU)", "Invoice Run"]
+}
+
+resource "cronitor_heartbeat_monitor" "jobs" {
+  for_each = toset(local.jobs)
+
+  key      = provider::cronitor::monitor_key(each.value)
+  name     = each.value
+  schedule = "0 2 * * *"
+}
@@ -0,0 +1,3 @@
+output "backup_runs" {
+  value = provider::cronitor::next_run_times("0 2 * * 1-5", "Europe/London", 5, plantimestamp())
+}
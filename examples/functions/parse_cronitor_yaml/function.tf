@@ -0,0 +1,32 @@
+locals {
+  cli_monitors = provider::cronitor::parse_cronitor_yaml(file("${path.module}/cronitor.yaml"))
+}
+
+resource "cronitor_heartbeat_monitor" "jobs" {
+  for_each = { for k, m in local.cli_monitors : k => m if m.type != "check" }
+
+  key        = each.key
+  name       = each.value.name
+  schedule   = each.value.schedule
+  timezone   = each.value.timezone
+  assertions = each.value.assertions
+  notify     = each.value.notify
+  tags       = each.value.tags
+}
+
+resource "cronitor_http_monitor" "checks" {
+  for_each = { for k, m in local.cli_monitors : k => m if m.type == "check" }
+
+  key        = each.key
+  name       = each.value.name
+  schedule   = each.value.schedule
+  assertions = each.value.assertions
+  notify     = each.value.notify
+
+  request {
+    url     = each.value.url
+    method  = each.value.method
+    headers = each.value.headers
+    regions = each.value.regions
+  }
+}
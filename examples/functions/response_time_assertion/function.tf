@@ -0,0 +1,12 @@
+resource "cronitor_http_monitor" "this" {
+  name     = "API"
+  schedule = "every 5 minutes"
+  assertions = [
+    provider::cronitor::response_time_assertion("<", 500),
+  ]
+
+  request {
+    url    = "https://api.example.com/health"
+    method = "GET"
+  }
+}
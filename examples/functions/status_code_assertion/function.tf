@@ -0,0 +1,10 @@
+resource "cronitor_http_monitor" "this" {
+  name       = "API"
+  schedule   = "every 5 minutes"
+  assertions = [provider::cronitor::status_code_assertion(200)]
+
+  request {
+    url    = "https://api.example.com/health"
+    method = "GET"
+  }
+}
@@ -0,0 +1,13 @@
+variable "schedule" {
+  type = string
+
+  validation {
+    condition     = provider::cronitor::validate_schedule(var.schedule)
+    error_message = "The schedule must be an interval, such as `every 5 minutes`, or a cron expression."
+  }
+}
+
+# Or fail with a description of what is wrong with the schedule
+output "checked" {
+  value = provider::cronitor::validate_schedule("0 25 * * *", true)
+}
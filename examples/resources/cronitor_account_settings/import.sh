@@ -0,0 +1,2 @@
+# The account settings can be imported with any id
+terraform import cronitor_account_settings.this account
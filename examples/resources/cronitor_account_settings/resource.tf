@@ -0,0 +1,5 @@
+resource "cronitor_account_settings" "this" {
+  default_realert_interval  = "every 8 hours"
+  default_notification_list = cronitor_notification_list.oncall.key
+  weekly_report_recipients  = ["engineering@example.com"]
+}
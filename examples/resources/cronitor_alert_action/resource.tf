@@ -0,0 +1,14 @@
+variable "incident_id" {
+  type = string
+}
+
+# Acknowledge the alert again for every new incident
+resource "cronitor_alert_action" "ack" {
+  monitor        = cronitor_http_monitor.api.key
+  note           = "Acknowledged by incident ${var.incident_id}"
+  ignore_missing = true
+
+  triggers = {
+    incident = var.incident_id
+  }
+}
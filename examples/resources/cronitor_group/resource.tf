@@ -0,0 +1,14 @@
+resource "cronitor_group" "this" {
+  name                     = "Backups"
+  default_notify           = [cronitor_notification_list.this.key]
+  default_tags             = ["backups"]
+  default_realert_interval = "every 2 hours"
+}
+
+# Monitors in the group that don't set notify, tags or realert_interval use the
+# group's defaults
+resource "cronitor_heartbeat_monitor" "this" {
+  name     = "Nightly backup"
+  schedule = "0 2 * * *"
+  group    = cronitor_group.this.key
+}
@@ -1,4 +1,5 @@
 resource "cronitor_heartbeat_monitor" "this" {
   name     = "Some monitor"
   schedule = "* * * * *"
+  note     = "Runbook: https://example.com/runbooks/some-monitor"
 }
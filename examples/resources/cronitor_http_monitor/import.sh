@@ -0,0 +1,8 @@
+# Monitors can be imported by key
+terraform import cronitor_http_monitor.this a1b2c3
+
+# or by name
+terraform import cronitor_http_monitor.this "name=Some monitor"
+
+# Configuration for existing monitors can be generated from import blocks
+terraform plan -generate-config-out=generated.tf
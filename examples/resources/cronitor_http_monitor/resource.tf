@@ -1,11 +1,14 @@
 resource "cronitor_http_monitor" "this" {
   name     = "Some monitor"
   schedule = "every 5 minutes"
-  url      = "https://registry.terraform.io/providers/henrywhitaker3/cronitor/latest"
-  method   = "GET"
   assertions = [
     "response.code = 200"
   ]
+
+  request {
+    url    = "https://registry.terraform.io/providers/henrywhitaker3/cronitor/latest"
+    method = "GET"
+  }
 }
 
 # Create a notification list and a monitor that uses it
@@ -19,12 +22,35 @@ resource "cronitor_notification_list" "this" {
 resource "cronitor_http_monitor" "this" {
   name     = "Some monitor"
   schedule = "every 5 minutes"
-  url      = "https://registry.terraform.io/providers/henrywhitaker3/cronitor/latest"
-  method   = "GET"
   assertions = [
     "response.code = 200"
   ]
   notify = [
     cronitor_notification_list.this.key,
   ]
+
+  request {
+    url    = "https://registry.terraform.io/providers/henrywhitaker3/cronitor/latest"
+    method = "GET"
+  }
+}
+
+# Page the on call team once the alert has gone unresolved for 30 minutes
+resource "cronitor_http_monitor" "escalating" {
+  name     = "Checkout"
+  schedule = "every 1 minute"
+  assertions = [
+    "response.code = 200"
+  ]
+  notify = [cronitor_notification_list.this.key]
+
+  request {
+    url    = "https://shop.example.com/health"
+    method = "GET"
+  }
+
+  escalation {
+    notify        = [cronitor_notification_list.oncall.key]
+    after_minutes = 30
+  }
 }
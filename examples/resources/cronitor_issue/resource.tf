@@ -0,0 +1,7 @@
+resource "cronitor_issue" "this" {
+  name         = "Degraded API performance"
+  body         = "We are investigating slow responses from the API."
+  severity     = "degraded_performance"
+  monitors     = [cronitor_http_monitor.api.key, cronitor_http_monitor.auth.key]
+  auto_resolve = true
+}
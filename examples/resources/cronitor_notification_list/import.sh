@@ -0,0 +1,5 @@
+# Notification lists can be imported by key
+terraform import cronitor_notification_list.this demo-a1b2c3
+
+# or by name
+terraform import cronitor_notification_list.this name=Demo
@@ -0,0 +1,24 @@
+resource "cronitor_status_page" "this" {
+  name          = "Acme"
+  subdomain     = "acme"
+  custom_domain = "status.acme.com"
+
+  section {
+    name     = "API"
+    monitors = [cronitor_http_monitor.api.key, cronitor_http_monitor.auth.key]
+  }
+
+  section {
+    name     = "Background jobs"
+    monitors = [cronitor_heartbeat_monitor.billing.key]
+  }
+}
+
+# Point the custom domain at the status page in the same apply
+resource "aws_route53_record" "status" {
+  zone_id = aws_route53_zone.acme.zone_id
+  name    = "status.acme.com"
+  type    = "CNAME"
+  ttl     = 300
+  records = [cronitor_status_page.this.custom_domain_target]
+}
@@ -0,0 +1,2 @@
+# Status page incidents can be imported by <status page>/<id>
+terraform import cronitor_status_page_incident.this acme/a1b2c3
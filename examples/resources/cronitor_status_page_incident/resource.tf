@@ -0,0 +1,16 @@
+resource "cronitor_status_page_incident" "maintenance" {
+  status_page = cronitor_status_page.this.key
+  title       = "Database upgrade"
+  status      = "in_progress"
+  components  = [cronitor_http_monitor.api.key]
+
+  update {
+    status  = "scheduled"
+    message = "The API will be read only between 02:00 and 03:00 UTC while we upgrade the database."
+  }
+
+  update {
+    status  = "in_progress"
+    message = "The upgrade has started."
+  }
+}
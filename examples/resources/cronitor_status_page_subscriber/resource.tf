@@ -0,0 +1,11 @@
+resource "cronitor_status_page_subscriber" "oncall" {
+  status_page = cronitor_status_page.this.key
+  type        = "email"
+  email       = "oncall@acme.com"
+}
+
+resource "cronitor_status_page_subscriber" "events" {
+  status_page = cronitor_status_page.this.key
+  type        = "webhook"
+  url         = "https://events.acme.com/cronitor"
+}
@@ -0,0 +1,2 @@
+# The webhook signing secret can be imported with any id
+terraform import cronitor_webhook_signing_secret.this webhook_signing_secret
@@ -0,0 +1,16 @@
+# Rotate the secret every quarter, and hand it to the service receiving the
+# webhooks
+resource "time_rotating" "quarterly" {
+  rotation_months = 3
+}
+
+resource "cronitor_webhook_signing_secret" "this" {
+  rotation_triggers = {
+    rotated = time_rotating.quarterly.id
+  }
+}
+
+resource "aws_secretsmanager_secret_version" "cronitor_webhook" {
+  secret_id     = aws_secretsmanager_secret.cronitor_webhook.id
+  secret_string = cronitor_webhook_signing_secret.this.secret
+}
@@ -0,0 +1,252 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+// accountSettingsID is the id of the account settings, as there is only one
+// per account.
+const accountSettingsID = "account"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccountSettingsResource{}
+var _ resource.ResourceWithImportState = &AccountSettingsResource{}
+
+func NewAccountSettingsResource() resource.Resource {
+	return &AccountSettingsResource{}
+}
+
+// AccountSettingsResource defines the resource implementation.
+type AccountSettingsResource struct {
+	client *cronitor.Client
+}
+
+func (r *AccountSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_settings"
+}
+
+func (r *AccountSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Account settings resource. There is only one per account, so it should only be declared once. Settings that aren't set are left as they are, and destroying the resource only removes it from state",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `" + accountSettingsID + "`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_realert_interval": schema.StringAttribute{
+				MarkdownDescription: "The realert interval of monitors that don't set their own",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(realertIntervalRegex, "must be in the form `every <n> <minutes|hours|days>`, such as `every 8 hours`"),
+				},
+			},
+			"default_notification_list": schema.StringAttribute{
+				MarkdownDescription: "The key of the notification list used by monitors that don't set `notify`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(listKeyRegex, "must only contain lowercase letters, numbers, dashes and underscores"),
+				},
+			},
+			"weekly_report_recipients": schema.ListAttribute{
+				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
+				MarkdownDescription: "The email addresses the weekly report is sent to",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *AccountSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cronitor.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cronitor.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccountSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data AccountSettingsModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	// The settings always exist, so creating them is the same as updating them
+	settings, err := r.client.UpdateAccountSettings(ctx, accountSettingsToRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update account settings", apiErrorDetail(ctx, err))
+		return
+	}
+
+	data = toAccountSettings(settings, data)
+
+	tflog.Trace(ctx, "updated the account settings")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data AccountSettingsModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	settings, err := r.client.GetAccountSettings(ctx)
+	if keepStateOffline(r.client, err, &resp.Diagnostics) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get account settings from api", apiErrorDetail(ctx, err))
+		return
+	}
+
+	data = toAccountSettings(settings, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var plan AccountSettingsModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	settings, err := r.client.UpdateAccountSettings(ctx, accountSettingsToRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update account settings", apiErrorDetail(ctx, err))
+		return
+	}
+
+	plan = toAccountSettings(settings, plan)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the settings from state, as the account always has them.
+func (r *AccountSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *AccountSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), accountSettingsID)...)
+}
+
+func (r *AccountSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccountSettingsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eachString(data.WeeklyReportRecipients, func(i int, v string) {
+		if addr, err := mail.ParseAddress(v); err != nil || addr.Address != v {
+			resp.Diagnostics.AddAttributeError(path.Root("weekly_report_recipients").AtListIndex(i), "invalid email", fmt.Sprintf("%q is not a valid email address", v))
+		}
+	})
+}
@@ -0,0 +1,210 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+const (
+	alertActionAcknowledge = "acknowledge"
+	alertActionResolve     = "resolve"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AlertActionResource{}
+
+func NewAlertActionResource() resource.Resource {
+	return &AlertActionResource{}
+}
+
+// AlertActionResource defines the resource implementation.
+type AlertActionResource struct {
+	client *cronitor.Client
+}
+
+func (r *AlertActionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_action"
+}
+
+func (r *AlertActionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Acknowledges or resolves the open alert for a monitor when it is created. Change `triggers` to run the action again. Destroying the resource does nothing",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The key of the monitor the action was run against",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitor": schema.StringAttribute{
+				MarkdownDescription: "The key of the monitor with the open alert",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "The action to run, one of `" + alertActionAcknowledge + "`, `" + alertActionResolve + "`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(alertActionAcknowledge),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(alertActionAcknowledge, alertActionResolve),
+				},
+			},
+			"note": schema.StringAttribute{
+				MarkdownDescription: "A note added to the alert, such as a link to the runbook run",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ignore_missing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to succeed when the monitor has no open alert",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that run the action again when they change",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"performed_at": schema.StringAttribute{
+				MarkdownDescription: "When the action was run, in RFC3339 format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *AlertActionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cronitor.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cronitor.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AlertActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data AlertActionModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	key := data.Monitor.ValueString()
+	var err error
+	switch data.Action.ValueString() {
+	case alertActionResolve:
+		err = r.client.ResolveAlert(ctx, key, data.Note.ValueString())
+	default:
+		err = r.client.AcknowledgeAlert(ctx, key, data.Note.ValueString())
+	}
+	if errors.Is(err, cronitor.ErrNotFound) && data.IgnoreMissing.ValueBool() {
+		tflog.Warn(ctx, "monitor has no open alert", map[string]any{"key": key})
+		err = nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to %s alert", data.Action.ValueString()), apiErrorDetail(ctx, err))
+		return
+	}
+
+	data.ID = types.StringValue(key)
+	data.PerformedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	tflog.Trace(ctx, "ran an alert action")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the prior state, as the action has already happened and there is
+// nothing to refresh.
+func (r *AlertActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update only saves the plan, as the timeouts are the only thing that can change
+// without replacing the alert action.
+func (r *AlertActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AlertActionModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the action from state, as an alert can't be
+// unacknowledged.
+func (r *AlertActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,229 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var responseTimeOperators = []string{"<", "<=", ">", ">="}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &StatusCodeAssertionFunction{}
+var _ function.Function = &ResponseTimeAssertionFunction{}
+var _ function.Function = &JsonAssertionFunction{}
+var _ function.Function = &HeaderAssertionFunction{}
+
+func NewStatusCodeAssertionFunction() function.Function {
+	return &StatusCodeAssertionFunction{}
+}
+
+func NewResponseTimeAssertionFunction() function.Function {
+	return &ResponseTimeAssertionFunction{}
+}
+
+func NewJsonAssertionFunction() function.Function {
+	return &JsonAssertionFunction{}
+}
+
+func NewHeaderAssertionFunction() function.Function {
+	return &HeaderAssertionFunction{}
+}
+
+// StatusCodeAssertionFunction builds an assertion on the response status code.
+type StatusCodeAssertionFunction struct{}
+
+func (f *StatusCodeAssertionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "status_code_assertion"
+}
+
+func (f *StatusCodeAssertionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a status code assertion",
+		MarkdownDescription: "Returns an assertion that the response has the status code, such as `response.code = 200`",
+		Parameters: []function.Parameter{
+			function.Int32Parameter{
+				Name:                "code",
+				MarkdownDescription: "The expected status code",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *StatusCodeAssertionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var code int32
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &code))
+	if resp.Error != nil {
+		return
+	}
+
+	if code < 100 || code > 599 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%d is not a valid status code", code))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, statusCodeAssertion(code)))
+}
+
+// ResponseTimeAssertionFunction builds an assertion on the response time.
+type ResponseTimeAssertionFunction struct{}
+
+func (f *ResponseTimeAssertionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "response_time_assertion"
+}
+
+func (f *ResponseTimeAssertionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a response time assertion",
+		MarkdownDescription: "Returns an assertion comparing the response time in milliseconds, such as `response.time < 500ms`",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "operator",
+				MarkdownDescription: "The comparison operator, one of `" + strings.Join(responseTimeOperators, "`, `") + "`",
+			},
+			function.Int32Parameter{
+				Name:                "milliseconds",
+				MarkdownDescription: "The response time to compare against",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ResponseTimeAssertionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var op string
+	var ms int32
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &op, &ms))
+	if resp.Error != nil {
+		return
+	}
+
+	if !slices.Contains(responseTimeOperators, op) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("operator must be one of %s", strings.Join(responseTimeOperators, ", ")))
+		return
+	}
+	if ms < 1 {
+		resp.Error = function.NewArgumentFuncError(1, "milliseconds must be at least 1")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, fmt.Sprintf("response.time %s %dms", op, ms)))
+}
+
+// JsonAssertionFunction builds an assertion on a value in a json response.
+type JsonAssertionFunction struct{}
+
+func (f *JsonAssertionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "json_assertion"
+}
+
+func (f *JsonAssertionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a json response assertion",
+		MarkdownDescription: "Returns an assertion comparing a value in the json response, such as `response.json \"data.status\" = \"ok\"`. Numbers, booleans and null are compared by value, anything else as a string",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "path",
+				MarkdownDescription: "The path to the value in the response, such as `data.status`",
+			},
+			function.StringParameter{
+				Name:                "operator",
+				MarkdownDescription: "The comparison operator, one of `" + strings.Join(jsonAssertionOperators, "`, `") + "`",
+			},
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "The value to compare against",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *JsonAssertionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var path, op, value string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &path, &op, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	if path == "" {
+		resp.Error = function.NewArgumentFuncError(0, "path must not be empty")
+		return
+	}
+	if !slices.Contains(jsonAssertionOperators, op) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("operator must be one of %s", strings.Join(jsonAssertionOperators, ", ")))
+		return
+	}
+
+	a := JsonAssertionModel{
+		Path:     types.StringValue(path),
+		Operator: types.StringValue(op),
+		Value:    types.StringValue(value),
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, a.assertion()))
+}
+
+// HeaderAssertionFunction builds an assertion on a response header.
+type HeaderAssertionFunction struct{}
+
+func (f *HeaderAssertionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "header_assertion"
+}
+
+func (f *HeaderAssertionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a response header assertion",
+		MarkdownDescription: "Returns an assertion comparing a response header, such as `response.header \"content-type\" contains \"json\"`",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The header name, which is compared case insensitively",
+			},
+			function.StringParameter{
+				Name:                "operator",
+				MarkdownDescription: "The comparison operator, one of `" + strings.Join(headerAssertionOperators, "`, `") + "`",
+			},
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "The value to compare against",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *HeaderAssertionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, op, value string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name, &op, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	if name == "" {
+		resp.Error = function.NewArgumentFuncError(0, "name must not be empty")
+		return
+	}
+	if !slices.Contains(headerAssertionOperators, op) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("operator must be one of %s", strings.Join(headerAssertionOperators, ", ")))
+		return
+	}
+
+	a := HeaderAssertionModel{
+		Name:     types.StringValue(name),
+		Operator: types.StringValue(op),
+		Value:    types.StringValue(value),
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, a.assertion()))
+}
@@ -0,0 +1,115 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var jsonAssertionOperators = []string{"=", "!=", "<", "<=", ">", ">=", "contains", "not contains"}
+
+var jsonAssertionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"path":     types.StringType,
+		"operator": types.StringType,
+		"value":    types.StringType,
+	},
+}
+
+var headerAssertionOperators = []string{"=", "!=", "contains", "not contains"}
+
+var headerAssertionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":     types.StringType,
+		"operator": types.StringType,
+		"value":    types.StringType,
+	},
+}
+
+func statusCodeAssertion(code int32) string {
+	return fmt.Sprintf("response.code = %d", code)
+}
+
+func responseTimeAssertion(ms int32) string {
+	return fmt.Sprintf("response.time < %dms", ms)
+}
+
+func durationAssertion(seconds int32) string {
+	return fmt.Sprintf("metric.duration < %d seconds", seconds)
+}
+
+// findDurationAssertion returns the number of seconds in the monitor's duration
+// assertion, if it has one.
+func findDurationAssertion(assertions []string) (int32, bool) {
+	for _, a := range assertions {
+		var seconds int32
+		if _, err := fmt.Sscanf(a, "metric.duration < %d seconds", &seconds); err == nil && a == durationAssertion(seconds) {
+			return seconds, true
+		}
+	}
+	return 0, false
+}
+
+func sslExpiryAssertion(days int32) string {
+	return fmt.Sprintf("ssl_certificate.expires_in > %d days", days)
+}
+
+func (a JsonAssertionModel) assertion() string {
+	return fmt.Sprintf("response.json %q %s %s", a.Path.ValueString(), a.Operator.ValueString(), assertionValue(a.Value.ValueString()))
+}
+
+// assertion compiles the header assertion, lower casing the header name so it
+// matches the way headers are compared.
+func (a HeaderAssertionModel) assertion() string {
+	return fmt.Sprintf("response.header %q %s %q", strings.ToLower(a.Name.ValueString()), a.Operator.ValueString(), a.Value.ValueString())
+}
+
+// assertionValue quotes the value unless it is a number, boolean or null, so
+// that it is compared with the right type.
+func assertionValue(v string) string {
+	if _, err := strconv.ParseFloat(v, 64); err == nil {
+		return v
+	}
+	switch v {
+	case "true", "false", "null":
+		return v
+	}
+	return strconv.Quote(v)
+}
+
+func toJsonAssertions(in types.List) []JsonAssertionModel {
+	out := []JsonAssertionModel{}
+	if in.IsNull() || in.IsUnknown() {
+		return out
+	}
+	in.ElementsAs(context.Background(), &out, false)
+	return out
+}
+
+func toHeaderAssertions(in types.List) []HeaderAssertionModel {
+	out := []HeaderAssertionModel{}
+	if in.IsNull() || in.IsUnknown() {
+		return out
+	}
+	in.ElementsAs(context.Background(), &out, false)
+	return out
+}
+
+// takeAssertion removes the assertion from the list if it is present, and
+// reports whether it was found.
+func takeAssertion(assertions *[]string, assertion string) bool {
+	idx := slices.Index(*assertions, assertion)
+	if idx == -1 {
+		return false
+	}
+	*assertions = slices.Delete(*assertions, idx, idx+1)
+	return true
+}
@@ -0,0 +1,237 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// cliSections maps the sections of a cronitor.yaml file to the type of the
+// monitors in them.
+var cliSections = map[string]string{
+	"jobs":       "job",
+	"checks":     "check",
+	"heartbeats": "heartbeat",
+	"monitors":   "",
+}
+
+var cliMonitorType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"key":           types.StringType,
+		"type":          types.StringType,
+		"name":          types.StringType,
+		"schedule":      types.StringType,
+		"timezone":      types.StringType,
+		"grace_seconds": types.Int32Type,
+		"assertions":    types.ListType{ElemType: types.StringType},
+		"notify":        types.ListType{ElemType: types.StringType},
+		"tags":          types.ListType{ElemType: types.StringType},
+		"environments":  types.ListType{ElemType: types.StringType},
+		"note":          types.StringType,
+		"paused":        types.BoolType,
+		"url":           types.StringType,
+		"method":        types.StringType,
+		"headers":       types.MapType{ElemType: types.StringType},
+		"body":          types.StringType,
+		"regions":       types.ListType{ElemType: types.StringType},
+	},
+}
+
+type cliMonitor struct {
+	Type         string     `yaml:"type"`
+	Name         string     `yaml:"name"`
+	Schedule     string     `yaml:"schedule"`
+	Timezone     string     `yaml:"timezone"`
+	GraceSeconds *int32     `yaml:"grace_seconds"`
+	Assertions   []string   `yaml:"assertions"`
+	Notify       cliNotify  `yaml:"notify"`
+	Tags         []string   `yaml:"tags"`
+	Environments []string   `yaml:"environments"`
+	Note         string     `yaml:"note"`
+	Paused       bool       `yaml:"paused"`
+	Request      cliRequest `yaml:"request"`
+}
+
+type cliRequest struct {
+	Url     string            `yaml:"url"`
+	Method  string            `yaml:"method"`
+	Headers map[string]string `yaml:"headers"`
+	Body    string            `yaml:"body"`
+	Regions []string          `yaml:"regions"`
+}
+
+// cliNotify is the notification lists of a monitor, which the cli accepts as a
+// list or as a map with the lists under alerts.
+type cliNotify []string
+
+func (n *cliNotify) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.MappingNode {
+		var out struct {
+			Alerts []string `yaml:"alerts"`
+		}
+		if err := value.Decode(&out); err != nil {
+			return err
+		}
+		*n = out.Alerts
+		return nil
+	}
+	var out []string
+	if err := value.Decode(&out); err != nil {
+		return err
+	}
+	*n = out
+	return nil
+}
+
+type CliMonitorModel struct {
+	Key          types.String `tfsdk:"key"`
+	Type         types.String `tfsdk:"type"`
+	Name         types.String `tfsdk:"name"`
+	Schedule     types.String `tfsdk:"schedule"`
+	Timezone     types.String `tfsdk:"timezone"`
+	GraceSeconds types.Int32  `tfsdk:"grace_seconds"`
+	Assertions   types.List   `tfsdk:"assertions"`
+	Notify       types.List   `tfsdk:"notify"`
+	Tags         types.List   `tfsdk:"tags"`
+	Environments types.List   `tfsdk:"environments"`
+	Note         types.String `tfsdk:"note"`
+	Paused       types.Bool   `tfsdk:"paused"`
+	Url          types.String `tfsdk:"url"`
+	Method       types.String `tfsdk:"method"`
+	Headers      types.Map    `tfsdk:"headers"`
+	Body         types.String `tfsdk:"body"`
+	Regions      types.List   `tfsdk:"regions"`
+}
+
+// parseCronitorYaml parses the monitors in a cronitor.yaml file, keyed by
+// monitor key.
+func parseCronitorYaml(content string) (map[string]CliMonitorModel, error) {
+	file := map[string]map[string]cliMonitor{}
+	if err := yaml.Unmarshal([]byte(content), &file); err != nil {
+		return nil, fmt.Errorf("invalid cronitor.yaml: %w", err)
+	}
+
+	sections := []string{}
+	for section := range file {
+		if _, ok := cliSections[section]; !ok {
+			return nil, fmt.Errorf("unknown section %q, expected one of jobs, checks, heartbeats, monitors", section)
+		}
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+
+	out := map[string]CliMonitorModel{}
+	for _, section := range sections {
+		for key, m := range file[section] {
+			if _, ok := out[key]; ok {
+				return nil, fmt.Errorf("monitor %q is defined more than once", key)
+			}
+			typ := cliSections[section]
+			if m.Type != "" {
+				typ = m.Type
+			}
+			if typ == "" {
+				return nil, fmt.Errorf("monitor %q in monitors must set a type", key)
+			}
+			name := m.Name
+			if name == "" {
+				name = key
+			}
+
+			headers := types.MapNull(types.StringType)
+			if len(m.Request.Headers) > 0 {
+				headers, _ = types.MapValueFrom(context.Background(), types.StringType, m.Request.Headers)
+			}
+			out[key] = CliMonitorModel{
+				Key:          types.StringValue(key),
+				Type:         types.StringValue(typ),
+				Name:         types.StringValue(name),
+				Schedule:     optionalString(m.Schedule),
+				Timezone:     optionalString(m.Timezone),
+				GraceSeconds: types.Int32PointerValue(m.GraceSeconds),
+				Assertions:   cliList(m.Assertions),
+				Notify:       cliList(m.Notify),
+				Tags:         cliList(m.Tags),
+				Environments: cliList(m.Environments),
+				Note:         optionalString(m.Note),
+				Paused:       types.BoolValue(m.Paused),
+				Url:          optionalString(m.Request.Url),
+				Method:       optionalString(m.Request.Method),
+				Headers:      headers,
+				Body:         optionalString(m.Request.Body),
+				Regions:      cliList(m.Request.Regions),
+			}
+		}
+	}
+	return out, nil
+}
+
+// cliList converts the list, using null when it is empty so that it can be
+// passed straight to optional attributes.
+func cliList(in []string) types.List {
+	if len(in) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	return stringSlice(in)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseCronitorYamlFunction{}
+
+func NewParseCronitorYamlFunction() function.Function {
+	return &ParseCronitorYamlFunction{}
+}
+
+// ParseCronitorYamlFunction parses the monitors in a cronitor cli config file.
+type ParseCronitorYamlFunction struct{}
+
+func (f *ParseCronitorYamlFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_cronitor_yaml"
+}
+
+func (f *ParseCronitorYamlFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parses a cronitor.yaml file",
+		MarkdownDescription: "Parses the monitors in a `cronitor.yaml` file used by the cronitor cli, returning them keyed by monitor key so they can be used with `for_each`. Monitors in `jobs` and `heartbeats` have the type `job` and `heartbeat`, `checks` have the type `check`, and those in `monitors` use their own `type`. Attributes that aren't set in the file are null",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "content",
+				MarkdownDescription: "The contents of the file, such as from `file(\"cronitor.yaml\")`",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: cliMonitorType,
+		},
+	}
+}
+
+func (f *ParseCronitorYamlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &content))
+	if resp.Error != nil {
+		return
+	}
+
+	monitors, err := parseCronitorYaml(content)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	out, diags := types.MapValueFrom(ctx, cliMonitorType, monitors)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, out))
+}
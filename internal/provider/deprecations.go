@@ -0,0 +1,81 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// deprecation is an attribute that maps to an api field cronitor has retired,
+// or is going to.
+type deprecation struct {
+	// Attribute matches the deprecated attribute, which can be nested.
+	Attribute path.Expression
+	// Replacement is the attribute to use instead, empty when there isn't one.
+	Replacement string
+	// Removal is when cronitor stops accepting the field, empty when that
+	// hasn't been announced.
+	Removal string
+}
+
+func (d deprecation) message(p path.Path) string {
+	msg := fmt.Sprintf("%s is deprecated by cronitor", p)
+	if d.Removal != "" {
+		msg += fmt.Sprintf(" and will stop working on %s", d.Removal)
+	}
+	if d.Replacement != "" {
+		msg += fmt.Sprintf(", use %s instead", d.Replacement)
+	}
+	return msg
+}
+
+// The deprecated attributes of each resource. Add entries here when cronitor
+// announces a field is being retired, so configs using it get a warning at plan
+// well before the api starts rejecting it.
+var (
+	httpMonitorDeprecations      = []deprecation{}
+	heartbeatMonitorDeprecations = []deprecation{}
+	notificationListDeprecations = []deprecation{}
+)
+
+var _ resource.ConfigValidator = deprecationValidator{}
+
+// deprecationValidator warns about deprecated attributes that are set.
+type deprecationValidator struct {
+	deprecations []deprecation
+}
+
+func (v deprecationValidator) Description(ctx context.Context) string {
+	attrs := []string{}
+	for _, d := range v.deprecations {
+		attrs = append(attrs, d.Attribute.String())
+	}
+	return fmt.Sprintf("warns when deprecated attributes are set: %s", strings.Join(attrs, ", "))
+}
+
+func (v deprecationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v deprecationValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	for _, d := range v.deprecations {
+		paths, diags := req.Config.PathMatches(ctx, d.Attribute)
+		resp.Diagnostics.Append(diags...)
+
+		for _, p := range paths {
+			var val attr.Value
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &val)...)
+			if val == nil || val.IsNull() {
+				continue
+			}
+			resp.Diagnostics.AddAttributeWarning(p, "deprecated attribute", d.message(p))
+		}
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+var environmentOverrideType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"environment": types.StringType,
+		"schedule":    types.StringType,
+		"notify":      unorderedStringListType,
+		"assertions":  unorderedStringListType,
+	},
+}
+
+type EnvironmentOverrideModel struct {
+	Environment types.String       `tfsdk:"environment"`
+	Schedule    types.String       `tfsdk:"schedule"`
+	Notify      UnorderedListValue `tfsdk:"notify"`
+	Assertions  UnorderedListValue `tfsdk:"assertions"`
+}
+
+func environmentOverridesBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Settings that replace the monitor's own settings in a single environment, only the attributes that are set are overridden",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"environment": schema.StringAttribute{
+					MarkdownDescription: "The environment the overrides apply to, which must be one of the monitor's environments",
+					Required:            true,
+				},
+				"schedule": schema.StringAttribute{
+					MarkdownDescription: "The schedule used in the environment",
+					Optional:            true,
+				},
+				"notify": schema.ListAttribute{
+					ElementType:         types.StringType,
+					CustomType:          unorderedStringListType,
+					MarkdownDescription: "The notification lists used in the environment",
+					Optional:            true,
+				},
+				"assertions": schema.ListAttribute{
+					ElementType:         types.StringType,
+					CustomType:          unorderedStringListType,
+					MarkdownDescription: "The assertions used in the environment",
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
+func toEnvironmentOverrideModels(in types.List) []EnvironmentOverrideModel {
+	out := []EnvironmentOverrideModel{}
+	if in.IsNull() || in.IsUnknown() {
+		return out
+	}
+	in.ElementsAs(context.Background(), &out, false)
+	return out
+}
+
+func toEnvironmentOverrides(in types.List) []cronitor.EnvironmentOverride {
+	var out []cronitor.EnvironmentOverride
+	for _, o := range toEnvironmentOverrideModels(in) {
+		override := cronitor.EnvironmentOverride{
+			Environment: o.Environment.ValueString(),
+			Notify:      toStringSlice(o.Notify),
+			Assertions:  toStringSlice(o.Assertions),
+		}
+		if !o.Schedule.IsNull() && !o.Schedule.IsUnknown() {
+			schedule := o.Schedule.ValueString()
+			override.Schedule = &schedule
+		}
+		out = append(out, override)
+	}
+	return out
+}
+
+// fromEnvironmentOverrides converts the api overrides back into the block,
+// keeping them in the same order as the prior ones.
+func fromEnvironmentOverrides(in []cronitor.EnvironmentOverride, prior types.List) types.List {
+	priorModels := toEnvironmentOverrideModels(prior)
+	byEnv := map[string]cronitor.EnvironmentOverride{}
+	for _, o := range in {
+		byEnv[o.Environment] = o
+	}
+
+	order := []string{}
+	for _, p := range priorModels {
+		if _, ok := byEnv[p.Environment.ValueString()]; ok {
+			order = append(order, p.Environment.ValueString())
+		}
+	}
+	for _, o := range in {
+		if !slices.ContainsFunc(priorModels, func(p EnvironmentOverrideModel) bool { return p.Environment.ValueString() == o.Environment }) {
+			order = append(order, o.Environment)
+		}
+	}
+
+	out := []EnvironmentOverrideModel{}
+	for _, env := range order {
+		o := byEnv[env]
+		out = append(out, EnvironmentOverrideModel{
+			Environment: types.StringValue(o.Environment),
+			Schedule:    types.StringPointerValue(o.Schedule),
+			Notify:      unorderedStringList(o.Notify),
+			Assertions:  unorderedStringList(o.Assertions),
+		})
+	}
+
+	list, _ := types.ListValueFrom(context.Background(), environmentOverrideType, out)
+	return list
+}
+
+func validateEnvironmentOverrides(overrides types.List, environments UnorderedListValue, diags *diag.Diagnostics) {
+	// The environments are chosen by cronitor when they aren't set
+	if overrides.IsUnknown() || environments.IsNull() || environments.IsUnknown() {
+		return
+	}
+	envs := toStringSlice(environments)
+	seen := map[string]bool{}
+	for i, o := range toEnvironmentOverrideModels(overrides) {
+		if o.Environment.IsUnknown() {
+			continue
+		}
+		env := o.Environment.ValueString()
+		p := path.Root("environment_overrides").AtListIndex(i).AtName("environment")
+		if seen[env] {
+			diags.AddAttributeError(p, "duplicate environment override", fmt.Sprintf("%s can only be overridden once", env))
+		}
+		seen[env] = true
+		if !slices.Contains(envs, env) {
+			diags.AddAttributeError(p, "unknown environment", fmt.Sprintf("%s must be one of the monitor's environments", env))
+		}
+	}
+}
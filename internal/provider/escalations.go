@@ -0,0 +1,107 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+var escalationType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"notify":        unorderedStringListType,
+		"after_alerts":  types.Int32Type,
+		"after_minutes": types.Int32Type,
+	},
+}
+
+type EscalationModel struct {
+	Notify       UnorderedListValue `tfsdk:"notify"`
+	AfterAlerts  types.Int32        `tfsdk:"after_alerts"`
+	AfterMinutes types.Int32        `tfsdk:"after_minutes"`
+}
+
+func escalationsBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Notification lists that are alerted as well as `notify` once an alert has gone unresolved for long enough. Escalations set in the UI are removed",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"notify": schema.ListAttribute{
+					ElementType:         types.StringType,
+					CustomType:          unorderedStringListType,
+					MarkdownDescription: "The notification lists the alert is escalated to",
+					Required:            true,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+				},
+				"after_alerts": schema.Int32Attribute{
+					MarkdownDescription: "Escalate after this many consecutive alerts",
+					Optional:            true,
+					Validators: []validator.Int32{
+						int32validator.AtLeast(1),
+					},
+				},
+				"after_minutes": schema.Int32Attribute{
+					MarkdownDescription: "Escalate once the alert has been unresolved for this many minutes",
+					Optional:            true,
+					Validators: []validator.Int32{
+						int32validator.AtLeast(1),
+					},
+				},
+			},
+			Validators: []validator.Object{
+				objectvalidator.ExactlyOneOf(
+					path.MatchRelative().AtName("after_alerts"),
+					path.MatchRelative().AtName("after_minutes"),
+				),
+			},
+		},
+	}
+}
+
+func toEscalationModels(in types.List) []EscalationModel {
+	out := []EscalationModel{}
+	if in.IsNull() || in.IsUnknown() {
+		return out
+	}
+	in.ElementsAs(context.Background(), &out, false)
+	return out
+}
+
+func toAlertRules(in types.List) []cronitor.AlertRule {
+	out := []cronitor.AlertRule{}
+	for _, e := range toEscalationModels(in) {
+		out = append(out, cronitor.AlertRule{
+			Notify:       toStringSlice(e.Notify),
+			AfterAlerts:  intPointer(e.AfterAlerts),
+			AfterMinutes: intPointer(e.AfterMinutes),
+		})
+	}
+	return out
+}
+
+// fromAlertRules converts the api rules back into the block.
+func fromAlertRules(in []cronitor.AlertRule) types.List {
+	out := []EscalationModel{}
+	for _, r := range in {
+		out = append(out, EscalationModel{
+			Notify:       unorderedStringList(r.Notify),
+			AfterAlerts:  int32Value(r.AfterAlerts),
+			AfterMinutes: int32Value(r.AfterMinutes),
+		})
+	}
+
+	list, _ := types.ListValueFrom(context.Background(), escalationType, out)
+	return list
+}
@@ -0,0 +1,95 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	contentTypeHeader  = "content-type"
+	graphqlContentType = "application/json"
+)
+
+var graphqlType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"query":     types.StringType,
+		"variables": types.StringType,
+	},
+}
+
+type graphqlPayload struct {
+	Query     string          `json:"query"`
+	Variables json.RawMessage `json:"variables,omitempty"`
+}
+
+func toGraphqlModel(in types.Object) (GraphqlModel, bool) {
+	out := GraphqlModel{}
+	if in.IsNull() || in.IsUnknown() {
+		return out, false
+	}
+	in.As(context.Background(), &out, basetypes.ObjectAsOptions{})
+	return out, true
+}
+
+// graphqlBody builds the json request body sent for a graphql query.
+func graphqlBody(g GraphqlModel) string {
+	p := graphqlPayload{Query: g.Query.ValueString()}
+	if v := g.Variables.ValueString(); v != "" {
+		p.Variables = json.RawMessage(v)
+	}
+	by, _ := json.Marshal(p)
+	return string(by)
+}
+
+// fromGraphqlBody parses a request body back into the graphql model. Values that
+// are equivalent to the prior ones once whitespace and json formatting are
+// ignored are kept as they were, so server side formatting doesn't cause diffs.
+func fromGraphqlBody(body string, prior GraphqlModel) (GraphqlModel, bool) {
+	p := graphqlPayload{}
+	if err := json.Unmarshal([]byte(body), &p); err != nil || p.Query == "" {
+		return GraphqlModel{}, false
+	}
+
+	out := GraphqlModel{
+		Query:     types.StringValue(p.Query),
+		Variables: types.StringNull(),
+	}
+	if normalizeQuery(p.Query) == normalizeQuery(prior.Query.ValueString()) {
+		out.Query = prior.Query
+	}
+	if len(p.Variables) > 0 && string(p.Variables) != "null" {
+		out.Variables = types.StringValue(string(p.Variables))
+		if jsonEqual(p.Variables, []byte(prior.Variables.ValueString())) {
+			out.Variables = prior.Variables
+		}
+	}
+
+	return out, true
+}
+
+func normalizeQuery(q string) string {
+	return strings.Join(strings.Fields(q), " ")
+}
+
+// jsonEqual reports whether both documents decode to the same value.
+func jsonEqual(a, b []byte) bool {
+	var va, vb any
+	if err := json.Unmarshal(a, &va); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &vb); err != nil {
+		return false
+	}
+	ra, _ := json.Marshal(va)
+	rb, _ := json.Marshal(vb)
+	return bytes.Equal(ra, rb)
+}
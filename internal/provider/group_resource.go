@@ -0,0 +1,278 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupResource{}
+var _ resource.ResourceWithImportState = &GroupResource{}
+
+func NewGroupResource() resource.Resource {
+	return &GroupResource{}
+}
+
+// GroupResource defines the resource implementation.
+type GroupResource struct {
+	client *cronitor.Client
+}
+
+func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Group resource. The defaults are used by monitors in the group that don't set their own, and are applied when the monitor is created or updated",
+
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The group id, generated by cronitor when not set. Changing this creates a new group",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(monitorKeyRegex, "must only contain letters, numbers, dashes and underscores"),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The group name",
+				Required:            true,
+			},
+			"default_notify": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Where alerts are sent for monitors in the group that don't set `notify`",
+				Optional:            true,
+			},
+			"default_tags": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The tags of monitors in the group that don't set `tags`",
+				Optional:            true,
+			},
+			"default_realert_interval": schema.StringAttribute{
+				MarkdownDescription: "The realert interval of monitors in the group that don't set `realert_interval`",
+				Optional:            true,
+			},
+			"monitor_keys": schema.ListAttribute{
+				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
+				MarkdownDescription: "The keys of the monitors in the group. Membership is only managed from the group when this is set, and it shouldn't be used alongside `group` on the monitors",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cronitor.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cronitor.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data GroupModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	group, err := r.client.CreateGroup(ctx, groupToGroupRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create group", apiErrorDetail(ctx, err))
+		return
+	}
+
+	data = toGroup(group, data)
+
+	tflog.Trace(ctx, "created a group")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data GroupModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	group, err := r.client.GetGroup(ctx, data.Key.ValueString())
+	if errors.Is(err, cronitor.ErrNotFound) {
+		tflog.Warn(ctx, "group no longer exists, removing from state", map[string]any{"key": data.Key.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if keepStateOffline(r.client, err, &resp.Diagnostics) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get group from api", apiErrorDetail(ctx, err))
+		return
+	}
+
+	data = toGroup(group, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var state GroupModel
+	var plan GroupModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	upd := groupToGroupRequest(plan)
+	upd.Key = state.Key.ValueString()
+	group, err := r.client.UpdateGroup(ctx, upd)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update group", apiErrorDetail(ctx, err))
+		return
+	}
+
+	state = toGroup(group, plan)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data GroupModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	// Something else has already deleted it, which is what we wanted anyway
+	if err := r.client.DeleteGroup(ctx, data.Key.ValueString()); err != nil && !errors.Is(err, cronitor.ErrNotFound) {
+		resp.Diagnostics.AddError("failed to delete group", apiErrorDetail(ctx, err))
+		return
+	}
+}
+
+func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+}
+
+func (r *GroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GroupModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DefaultRealertInterval.IsNull() && !data.DefaultRealertInterval.IsUnknown() && !realertIntervalRegex.MatchString(data.DefaultRealertInterval.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_realert_interval"),
+			"invalid default_realert_interval",
+			"must be in the form `every <n> <minutes|hours|days>`, such as `every 8 hours`",
+		)
+	}
+}
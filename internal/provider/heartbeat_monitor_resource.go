@@ -5,18 +5,22 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
@@ -25,6 +29,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &HeartbeatMonitorResource{}
 var _ resource.ResourceWithImportState = &HeartbeatMonitorResource{}
+var _ resource.ResourceWithConfigValidators = &HeartbeatMonitorResource{}
 
 func NewHeartbeatMonitorResource() resource.Resource {
 	return &HeartbeatMonitorResource{}
@@ -46,12 +51,36 @@ func (r *HeartbeatMonitorResource) Schema(ctx context.Context, req resource.Sche
 
 		Attributes: map[string]schema.Attribute{
 			"key": schema.StringAttribute{
-				MarkdownDescription: "The monitor id",
+				MarkdownDescription: "The monitor id, generated by cronitor when not set. Changing this creates a new monitor",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(monitorKeyRegex, "must only contain letters, numbers, dashes and underscores"),
+				},
+			},
+			"dashboard_url": schema.StringAttribute{
+				MarkdownDescription: "The url of the monitor in the cronitor dashboard",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"passing": schema.BoolAttribute{
+				MarkdownDescription: "Whether the monitor is currently passing",
+				Computed:            true,
+			},
+			"running": schema.BoolAttribute{
+				MarkdownDescription: "Whether the monitor is currently running",
+				Computed:            true,
+			},
+			"initialized": schema.BoolAttribute{
+				MarkdownDescription: "Whether the monitor has received any telemetry or run any checks yet",
+				Computed:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The monitor name",
 				Required:            true,
@@ -63,16 +92,12 @@ func (r *HeartbeatMonitorResource) Schema(ctx context.Context, req resource.Sche
 				Default:             booldefault.StaticBool(false),
 			},
 			"failure_tolerance": schema.Int32Attribute{
-				MarkdownDescription: "The number of times the monitor can fail before triggering an alert",
+				MarkdownDescription: "The number of times the monitor can fail before triggering an alert, the cronitor default is used when not set and removing it resets it to the default",
 				Optional:            true,
-				Computed:            true,
-				Default:             int32default.StaticInt32(0),
 			},
 			"grace_seconds": schema.Int32Attribute{
-				MarkdownDescription: "The number of seconds to wait after failure before triggering an alert",
+				MarkdownDescription: "The number of seconds to wait after failure before triggering an alert, the cronitor default is used when not set and removing it resets it to the default",
 				Optional:            true,
-				Computed:            true,
-				Default:             int32default.StaticInt32(0),
 			},
 			"paused": schema.BoolAttribute{
 				MarkdownDescription: "Whether the monitor is paused",
@@ -80,25 +105,83 @@ func (r *HeartbeatMonitorResource) Schema(ctx context.Context, req resource.Sche
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"snooze_until": schema.StringAttribute{
+				MarkdownDescription: "An RFC3339 timestamp to pause the monitor until, for silencing alerts during known maintenance",
+				Optional:            true,
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "When creating the monitor fails because it already exists, adopt the existing monitor with the same key, or name when no key is set, and update it",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevent the monitor from being destroyed, this must be set to false and applied before it can be destroyed",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"status_page_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevent the monitor from being destroyed while it is shown on a status page, so removing it doesn't leave a gap on the page",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"pause_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Pause the monitor instead of deleting it when it is destroyed, keeping its history",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"realert_interval": schema.StringAttribute{
-				MarkdownDescription: "The interval that alerts are re-sent at",
+				MarkdownDescription: "The interval that alerts are re-sent at, inherited from the group or the cronitor default when not set",
 				Optional:            true,
+			},
+			"effective_notify": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Where alerts are sent, including any inherited from the group",
+				Computed:            true,
+			},
+			"effective_tags": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The monitor tags, including any inherited from the group",
+				Computed:            true,
+			},
+			"effective_realert_interval": schema.StringAttribute{
+				MarkdownDescription: "The interval that alerts are re-sent at, including any inherited from the group",
 				Computed:            true,
-				Default:             stringdefault.StaticString("every 8 hours"),
 			},
 			"schedule": schema.StringAttribute{
 				MarkdownDescription: "The schedule the monitor runs on",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("schedule_spec"), path.MatchRoot("every_seconds")),
+				},
+			},
+			"schedule_spec": scheduleSpecAttribute(),
+			"schedule_type": schema.StringAttribute{
+				MarkdownDescription: "The type of schedule, one of `cron`, `interval`. Interval schedules are set with `every_seconds`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(scheduleTypeCron, scheduleTypeInterval),
+				},
+			},
+			"every_seconds": schema.Int32Attribute{
+				MarkdownDescription: "The number of seconds a ping is expected every, when `schedule_type` is `interval`",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
 			},
 			"schedule_tolerance": schema.Int32Attribute{
-				MarkdownDescription: "The number of missed scheduled executions before triggering an alert",
+				MarkdownDescription: "The number of missed scheduled executions before triggering an alert, the cronitor default is used when not set and removing it resets it to the default",
 				Optional:            true,
-				Computed:            true,
-				Default:             int32default.StaticInt32(0),
 			},
 			"tags": schema.ListAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "The monitor tags",
+				CustomType:          tagListType,
+				MarkdownDescription: "The monitor tags, inherited from the group when not set. Tags are compared ignoring case and surrounding whitespace, and duplicates are dropped",
 				Optional:            true,
 			},
 			"timezone": schema.StringAttribute{
@@ -107,15 +190,26 @@ func (r *HeartbeatMonitorResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"notify": schema.ListAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "Where the alerts are sent when a failure occurs",
+				CustomType:          unorderedStringListType,
+				MarkdownDescription: "Where the alerts are sent when a failure occurs, inherited from the group or the cronitor default when not set",
 				Optional:            true,
-				Computed:            true,
-				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("default")})),
 			},
 			"environments": schema.ListAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "The environments the monitor runs in",
+				CustomType:          unorderedStringListType,
+				MarkdownDescription: "The environments the monitor runs in, the cronitor default is used when not set",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_duration_seconds": schema.Int32Attribute{
+				MarkdownDescription: "Alert when a run takes longer than this many seconds, added to the assertions as `metric.duration < <seconds> seconds`",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
 			},
 			"telemetry_url": schema.StringAttribute{
 				MarkdownDescription: "The url to send pings to",
@@ -125,10 +219,74 @@ func (r *HeartbeatMonitorResource) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"note": schema.StringAttribute{
+				MarkdownDescription: "A note shown alongside the monitor, left unchanged when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"run_url": schema.StringAttribute{
+				MarkdownDescription: "The url to ping when a run starts",
+				Sensitive:           true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"complete_url": schema.StringAttribute{
+				MarkdownDescription: "The url to ping when a run completes",
+				Sensitive:           true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fail_url": schema.StringAttribute{
+				MarkdownDescription: "The url to ping when a run fails",
+				Sensitive:           true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"telemetry_urls": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The urls to send pings to for each environment, keyed by environment",
+				Sensitive:           true,
+				Computed:            true,
+			},
+			"runbook_url": schema.StringAttribute{
+				MarkdownDescription: "A link to the runbook for the monitor, added to the end of the note so that it is included in alerts",
+				Optional:            true,
+			},
 			"group": schema.StringAttribute{
 				MarkdownDescription: "The group the monitor belongs to",
 				Optional:            true,
 			},
+			"position": schema.Int32Attribute{
+				MarkdownDescription: "The position of the monitor within its group, the cronitor default is used when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+					int32validator.AlsoRequires(path.MatchRoot("group")),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"environment_overrides": environmentOverridesBlock(),
+			"escalation":            escalationsBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -153,6 +311,8 @@ func (r *HeartbeatMonitorResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *HeartbeatMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
 	var data HeartbeatMonitorModel
 
 	// Read Terraform plan data into the model
@@ -162,14 +322,54 @@ func (r *HeartbeatMonitorResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	monitor, err := r.client.CreateMonitor(ctx, heartbeatToMonitorRequest(data))
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	mon := heartbeatToMonitorRequest(data)
+
+	monitor, err := r.client.CreateMonitor(ctx, mon)
+	if errors.Is(err, cronitor.ErrMonitorExists) && data.AdoptExisting.ValueBool() {
+		tflog.Info(ctx, "monitor already exists, adopting existing monitor", map[string]any{"error": err.Error()})
+		monitor, err = adoptMonitor(ctx, r.client, mon, err)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create monitor", apiErrorDetail(ctx, err))
+		return
+	}
+
+	monitor, err = getMonitorWithRetry(ctx, r.client, *monitor.Key)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to create monitor", err.Error())
+		resp.Diagnostics.AddError("failed to get created monitor", apiErrorDetail(ctx, err))
+		return
+	}
+
+	if err := snoozeMonitor(ctx, r.client, *monitor.Key, data.SnoozeUntil); err != nil {
+		resp.Diagnostics.AddError("failed to snooze monitor", apiErrorDetail(ctx, err))
 		return
 	}
 
 	data.Key = types.StringValue(*monitor.Key)
-	data.TelemetryUrl = types.StringValue(fmt.Sprintf("https://cronitor.link/p/%s/%s", r.client.ApiKey, *monitor.Key))
+	data.Note, data.RunbookUrl = splitRunbookNote(stringValue(monitor.Note), data.RunbookUrl)
+	data.Schedule = types.StringValue(monitor.Schedule)
+	data.GraceSeconds = optionalInt32(monitor.GraceSeconds, data.GraceSeconds, false)
+	data.Position = int32Value(monitor.Position)
+	data.FailureTolerance = optionalInt32(monitor.FailureTolerance, data.FailureTolerance, false)
+	data.ScheduleTolerance = optionalInt32(monitor.ScheduleTolerance, data.ScheduleTolerance, false)
+	data.DashboardUrl = types.StringValue(dashboardUrl(*monitor.Key))
+	data.setInherited(monitor, data.BaseMonitorModel)
+	data.Passing = types.BoolValue(monitor.Passing)
+	data.Running = types.BoolValue(monitor.Running)
+	data.Initialized = types.BoolValue(monitor.Initialized)
+	if data.Environments.IsUnknown() {
+		data.Environments = unorderedStringList(monitor.Environments)
+	}
+	data.setTelemetry(r.client, monitor)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -180,6 +380,8 @@ func (r *HeartbeatMonitorResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *HeartbeatMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
 	var data HeartbeatMonitorModel
 
 	// Read Terraform prior state data into the model
@@ -189,26 +391,47 @@ func (r *HeartbeatMonitorResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	state := heartbeatToMonitorRequest(data)
-
-	monitor, err := r.client.GetMonitor(ctx, data.Key.ValueString())
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	var monitor *cronitor.Monitor
+	var err error
+	if data.Name.IsNull() {
+		// Only the key is set after an import, which could be of a monitor
+		// that was created moments ago
+		monitor, err = getMonitorWithRetry(ctx, r.client, data.Key.ValueString())
+	} else {
+		monitor, err = r.client.GetMonitorBatched(ctx, data.Key.ValueString())
+	}
+	if errors.Is(err, cronitor.ErrNotFound) {
+		tflog.Warn(ctx, "monitor no longer exists, removing from state", map[string]any{"key": data.Key.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if keepStateOffline(r.client, err, &resp.Diagnostics) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("failed to get monitor from api", err.Error())
+		resp.Diagnostics.AddError("failed to get monitor from api", apiErrorDetail(ctx, err))
 		return
 	}
 
-	fixSliceOrder(state.Assertions, &monitor.Assertions)
-	fixSliceOrder(state.Environments, &monitor.Environments)
-	fixSliceOrder(state.Tags, &monitor.Tags)
-
-	data = toHeartbeatMonitor(monitor)
-	data.TelemetryUrl = types.StringValue(fmt.Sprintf("https://cronitor.link/p/%s/%s", r.client.ApiKey, *monitor.Key))
+	data = toHeartbeatMonitor(monitor, data)
+	data.setTelemetry(r.client, monitor)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *HeartbeatMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
 	var state HeartbeatMonitorModel
 	var plan HeartbeatMonitorModel
 
@@ -220,26 +443,39 @@ func (r *HeartbeatMonitorResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
 	upd := heartbeatToMonitorRequest(plan)
 	upd.Key = state.Key.ValueStringPointer()
-	monitor, err := r.client.UpdateMonitor(ctx, upd)
+	monitor, err := updateMonitor(ctx, r.client, heartbeatToMonitorRequest(state), upd)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to update heartbeat monitor", err.Error())
+		resp.Diagnostics.AddError("failed to update heartbeat monitor", apiErrorDetail(ctx, err))
 		return
 	}
 
-	fixSliceOrder(upd.Assertions, &monitor.Assertions)
-	fixSliceOrder(upd.Environments, &monitor.Environments)
-	fixSliceOrder(upd.Tags, &monitor.Tags)
+	// The update can clear the pause, so the snooze is always reapplied
+	if err := snoozeMonitor(ctx, r.client, *monitor.Key, plan.SnoozeUntil); err != nil {
+		resp.Diagnostics.AddError("failed to snooze monitor", apiErrorDetail(ctx, err))
+		return
+	}
 
-	state = toHeartbeatMonitor(monitor)
-	state.TelemetryUrl = types.StringValue(fmt.Sprintf("https://cronitor.link/p/%s/%s", r.client.ApiKey, *monitor.Key))
+	state = toHeartbeatMonitor(monitor, plan)
+	state.setTelemetry(r.client, monitor)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *HeartbeatMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
 	var data HeartbeatMonitorModel
 
 	// Read Terraform prior state data into the model
@@ -249,14 +485,55 @@ func (r *HeartbeatMonitorResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	if err := r.client.DeleteMonitor(ctx, data.Key.ValueString()); err != nil {
-		resp.Diagnostics.AddError("failed to delete record", err.Error())
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deletion_protection"),
+			"deletion protection enabled",
+			fmt.Sprintf("monitor %s has deletion_protection enabled, set it to false and apply before destroying it", data.Key.ValueString()),
+		)
+		return
+	}
+
+	if data.PauseOnDestroy.ValueBool() {
+		if err := r.client.PauseMonitor(ctx, data.Key.ValueString(), 0); err != nil {
+			resp.Diagnostics.AddError("failed to pause monitor", apiErrorDetail(ctx, err))
+		}
+		return
+	}
+
+	if data.StatusPageProtection.ValueBool() && !canDeleteFromStatusPages(ctx, r.client, data.Key.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	// Something else has already deleted it, which is what we wanted anyway
+	if err := r.client.DeleteMonitorBatched(ctx, data.Key.ValueString()); err != nil && !errors.Is(err, cronitor.ErrNotFound) {
+		resp.Diagnostics.AddError("failed to delete record", apiErrorDetail(ctx, err))
 		return
 	}
 }
 
+// ImportState imports the monitor by its key, or by its name when the id is in
+// the form name=<name>.
 func (r *HeartbeatMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	importMonitor(ctx, r.client, "heartbeat", req, resp)
+}
+
+func (r *HeartbeatMonitorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		heartbeatScheduleValidator{},
+		deprecationValidator{deprecations: heartbeatMonitorDeprecations},
+	}
 }
 
 func (r *HeartbeatMonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -268,6 +545,26 @@ func (r *HeartbeatMonitorResource) ValidateConfig(ctx context.Context, req resou
 		return
 	}
 
+	validateScheduleSpec(data.ScheduleSpec, &resp.Diagnostics)
+	validateRealertInterval(data.RealertInterval, &resp.Diagnostics)
+	validateEnvironmentOverrides(data.EnvironmentOverrides, data.Environments, &resp.Diagnostics)
+
+	if !data.SnoozeUntil.IsNull() && !data.SnoozeUntil.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, data.SnoozeUntil.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("snooze_until"), "invalid snooze_until", err.Error())
+		}
+	}
+
+	if !data.ScheduleType.IsUnknown() {
+		interval := data.ScheduleType.ValueString() == scheduleTypeInterval
+		if interval && data.EverySeconds.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("every_seconds"), "missing interval", "every_seconds must be set when schedule_type is interval")
+		}
+		if !interval && !data.EverySeconds.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("every_seconds"), "invalid schedule", "every_seconds can only be set when schedule_type is interval")
+		}
+	}
+
 	// if err := data.validate(); err != nil {
 	// 	resp.Diagnostics.AddError("monitor failed validation", err.Error())
 	// 	return
@@ -5,19 +5,30 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
@@ -25,7 +36,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &HttpMonitorResource{}
+var _ resource.ResourceWithUpgradeState = &HttpMonitorResource{}
 var _ resource.ResourceWithImportState = &HttpMonitorResource{}
+var _ resource.ResourceWithConfigValidators = &HttpMonitorResource{}
 
 func NewHttpMonitorResource() resource.Resource {
 	return &HttpMonitorResource{}
@@ -44,24 +57,75 @@ func (r *HttpMonitorResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "HTTP Monitor resource",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"key": schema.StringAttribute{
-				MarkdownDescription: "The monitor id",
+				MarkdownDescription: "The monitor id, generated by cronitor when not set. Changing this creates a new monitor",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(monitorKeyRegex, "must only contain letters, numbers, dashes and underscores"),
+				},
+			},
+			"dashboard_url": schema.StringAttribute{
+				MarkdownDescription: "The url of the monitor in the cronitor dashboard",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"passing": schema.BoolAttribute{
+				MarkdownDescription: "Whether the monitor is currently passing",
+				Computed:            true,
+			},
+			"running": schema.BoolAttribute{
+				MarkdownDescription: "Whether the monitor is currently running",
+				Computed:            true,
+			},
+			"initialized": schema.BoolAttribute{
+				MarkdownDescription: "Whether the monitor has received any telemetry or run any checks yet",
+				Computed:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The monitor name",
 				Required:            true,
 			},
 			"assertions": schema.ListAttribute{
 				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
 				MarkdownDescription: "The monitor assertions",
 				Optional:            true,
 			},
+			"expected_status_code": schema.Int32Attribute{
+				MarkdownDescription: "The status code the response must return, added to the assertions as `response.code = <code>`",
+				Optional:            true,
+			},
+			"expected_status_codes": schema.ListAttribute{
+				ElementType:         types.Int32Type,
+				MarkdownDescription: "The status codes the response must return, each added to the assertions as `response.code = <code>`",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ConflictsWith(path.MatchRoot("expected_status_code")),
+				},
+			},
+			"max_response_time_ms": schema.Int32Attribute{
+				MarkdownDescription: "The maximum response time in milliseconds, added to the assertions as `response.time < <ms>ms`",
+				Optional:            true,
+			},
+			"ssl_expires_within_days": schema.Int32Attribute{
+				MarkdownDescription: "Alert when the ssl certificate expires within this many days, added to the assertions as `ssl_certificate.expires_in > <days> days`",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
 			"disabled": schema.BoolAttribute{
 				MarkdownDescription: "Whether the monitor is disabled",
 				Optional:            true,
@@ -69,16 +133,12 @@ func (r *HttpMonitorResource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:             booldefault.StaticBool(false),
 			},
 			"failure_tolerance": schema.Int32Attribute{
-				MarkdownDescription: "The number of times the monitor can fail before triggering an alert",
+				MarkdownDescription: "The number of times the monitor can fail before triggering an alert, the cronitor default is used when not set and removing it resets it to the default",
 				Optional:            true,
-				Computed:            true,
-				Default:             int32default.StaticInt32(0),
 			},
 			"grace_seconds": schema.Int32Attribute{
-				MarkdownDescription: "The number of seconds to wait after failure before triggering an alert",
+				MarkdownDescription: "The number of seconds to wait after failure before triggering an alert, the cronitor default is used when not set and removing it resets it to the default",
 				Optional:            true,
-				Computed:            true,
-				Default:             int32default.StaticInt32(0),
 			},
 			"paused": schema.BoolAttribute{
 				MarkdownDescription: "Whether the monitor is paused",
@@ -86,72 +146,79 @@ func (r *HttpMonitorResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
-			"realert_interval": schema.StringAttribute{
-				MarkdownDescription: "The interval that alerts are re-sent at",
+			"snooze_until": schema.StringAttribute{
+				MarkdownDescription: "An RFC3339 timestamp to pause the monitor until, for silencing alerts during known maintenance",
 				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString("every 8 hours"),
-			},
-			"url": schema.StringAttribute{
-				MarkdownDescription: "The url of the resource to monitor",
-				Required:            true,
 			},
-			"headers": schema.MapAttribute{
-				ElementType:         types.StringType,
-				MarkdownDescription: "The headers sent with the request",
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "When creating the monitor fails because it already exists, adopt the existing monitor with the same key, or name when no key is set, and update it",
 				Optional:            true,
-				// Default:             emptyMap(),
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
-			"cookies": schema.MapAttribute{
-				ElementType:         types.StringType,
-				MarkdownDescription: "The cookies sent with the request",
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevent the monitor from being destroyed, this must be set to false and applied before it can be destroyed",
 				Optional:            true,
-				// Default:             emptyMap(),
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
-			"body": schema.StringAttribute{
-				MarkdownDescription: "The body sent with the request",
+			"status_page_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevent the monitor from being destroyed while it is shown on a status page, so removing it doesn't leave a gap on the page",
 				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
-			"method": schema.StringAttribute{
-				MarkdownDescription: "The method of the request",
-				Required:            true,
+			"pause_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Pause the monitor instead of deleting it when it is destroyed, keeping its history",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
-			"timeout_seconds": schema.Int32Attribute{
-				MarkdownDescription: "The numbers of seconds to wait for a response",
+			"realert_interval": schema.StringAttribute{
+				MarkdownDescription: "The interval that alerts are re-sent at, inherited from the group or the cronitor default when not set",
 				Optional:            true,
+			},
+			"effective_notify": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Where alerts are sent, including any inherited from the group",
 				Computed:            true,
-				Default:             int32default.StaticInt32(5),
 			},
-			"regions": schema.ListAttribute{
+			"effective_tags": schema.ListAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "The regions to run the test from",
-				Optional:            true,
+				MarkdownDescription: "The monitor tags, including any inherited from the group",
+				Computed:            true,
 			},
-			"follow_redirects": schema.BoolAttribute{
-				MarkdownDescription: "Whether to follow redirects of the response",
-				Optional:            true,
+			"effective_realert_interval": schema.StringAttribute{
+				MarkdownDescription: "The interval that alerts are re-sent at, including any inherited from the group",
 				Computed:            true,
-				Default:             booldefault.StaticBool(true),
 			},
-			"verify_ssl": schema.BoolAttribute{
-				MarkdownDescription: "Whether to verify the ssl certificate of the response",
+			"headers_multi": schema.MapAttribute{
+				ElementType:         types.ListType{ElemType: types.StringType},
+				MarkdownDescription: "Headers sent with the request that have multiple values, the values are joined into a single header",
 				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(true),
+			},
+			"bearer_token": schema.StringAttribute{
+				MarkdownDescription: "A bearer token sent in the authorization header of the request",
+				Optional:            true,
+				Sensitive:           true,
 			},
 			"schedule": schema.StringAttribute{
 				MarkdownDescription: "The schedule the monitor runs on",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("schedule_spec")),
+				},
 			},
+			"schedule_spec": scheduleSpecAttribute(),
 			"schedule_tolerance": schema.Int32Attribute{
-				MarkdownDescription: "The number of missed scheduled executions before triggering an alert",
+				MarkdownDescription: "The number of missed scheduled executions before triggering an alert, the cronitor default is used when not set and removing it resets it to the default",
 				Optional:            true,
-				Computed:            true,
-				Default:             int32default.StaticInt32(0),
 			},
 			"tags": schema.ListAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "The monitor tags",
+				CustomType:          tagListType,
+				MarkdownDescription: "The monitor tags, inherited from the group when not set. Tags are compared ignoring case and surrounding whitespace, and duplicates are dropped",
 				Optional:            true,
 			},
 			"timezone": schema.StringAttribute{
@@ -160,26 +227,214 @@ func (r *HttpMonitorResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"notify": schema.ListAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "Where the alerts are sent when a failure occurs",
+				CustomType:          unorderedStringListType,
+				MarkdownDescription: "Where the alerts are sent when a failure occurs, inherited from the group or the cronitor default when not set",
 				Optional:            true,
-				Computed:            true,
-				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("default")})),
 			},
 			"environments": schema.ListAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "The environments the monitor runs in",
+				CustomType:          unorderedStringListType,
+				MarkdownDescription: "The environments the monitor runs in, the cronitor default is used when not set",
 				Optional:            true,
 				Computed:            true,
-				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("production")})),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"note": schema.StringAttribute{
+				MarkdownDescription: "A note shown alongside the monitor, left unchanged when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"runbook_url": schema.StringAttribute{
+				MarkdownDescription: "A link to the runbook for the monitor, added to the end of the note so that it is included in alerts",
+				Optional:            true,
 			},
 			"group": schema.StringAttribute{
 				MarkdownDescription: "The group the monitor belongs to",
 				Optional:            true,
 			},
+			"position": schema.Int32Attribute{
+				MarkdownDescription: "The position of the monitor within its group, the cronitor default is used when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+					int32validator.AlsoRequires(path.MatchRoot("group")),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"environment_overrides": environmentOverridesBlock(),
+			"escalation":            escalationsBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"request": schema.SingleNestedBlock{
+				MarkdownDescription: "The request sent to check the resource, `headers_multi`, `bearer_token` and `graphql` are added to it when set",
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "The url of the resource to monitor",
+						Required:            true,
+					},
+					"method": schema.StringAttribute{
+						MarkdownDescription: "The method of the request",
+						Required:            true,
+					},
+					"headers": schema.MapAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "The headers sent with the request",
+						Optional:            true,
+					},
+					"cookies": schema.MapAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "The cookies sent with the request",
+						Optional:            true,
+					},
+					"body": schema.StringAttribute{
+						CustomType:          RequestBodyType{},
+						MarkdownDescription: "The body sent with the request, json bodies that only differ in formatting are treated as the same",
+						Optional:            true,
+					},
+					"timeout_seconds": schema.Int32Attribute{
+						MarkdownDescription: "The numbers of seconds to wait for a response",
+						Optional:            true,
+						Computed:            true,
+						Default:             int32default.StaticInt32(5),
+					},
+					"regions": schema.ListAttribute{
+						ElementType:         types.StringType,
+						CustomType:          unorderedStringListType,
+						MarkdownDescription: "The regions to run the test from",
+						Optional:            true,
+					},
+					"follow_redirects": schema.BoolAttribute{
+						MarkdownDescription: "Whether to follow redirects of the response",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"max_redirects": schema.Int32Attribute{
+						MarkdownDescription: "The maximum number of redirects to follow when `follow_redirects` is enabled",
+						Optional:            true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(0),
+						},
+					},
+					"verify_ssl": schema.BoolAttribute{
+						MarkdownDescription: "Whether to verify the ssl certificate of the response",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"client_cert_pem": schema.StringAttribute{
+						MarkdownDescription: "A pem encoded client certificate presented to endpoints that require mutual tls",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_key_pem")),
+						},
+					},
+					"client_key_pem": schema.StringAttribute{
+						MarkdownDescription: "The pem encoded private key for `client_cert_pem`",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_cert_pem")),
+						},
+					},
+					"ip_version": schema.StringAttribute{
+						MarkdownDescription: "The ip version used to connect to the url, one of `" + strings.Join(ipVersions, "`, `") + "`",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(ipVersionAny),
+						Validators: []validator.String{
+							stringvalidator.OneOf(ipVersions...),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.IsRequired(),
+				},
+			},
+			"graphql": schema.SingleNestedBlock{
+				MarkdownDescription: "A graphql query to send as the json body of the request, the method must be `POST`",
+				Attributes: map[string]schema.Attribute{
+					"query": schema.StringAttribute{
+						MarkdownDescription: "The graphql query",
+						Optional:            true,
+					},
+					"variables": schema.StringAttribute{
+						MarkdownDescription: "The json encoded variables for the query",
+						Optional:            true,
+					},
+				},
+			},
+			"header_assertion": schema.ListNestedBlock{
+				MarkdownDescription: "Assertions against the response headers, added to the assertions as `response.header \"<name>\" <operator> \"<value>\"`",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the header, compared in lower case",
+							Required:            true,
+						},
+						"operator": schema.StringAttribute{
+							MarkdownDescription: "The comparison operator, one of `" + strings.Join(headerAssertionOperators, "`, `") + "`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(headerAssertionOperators...),
+							},
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value to compare against",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"json_assertion": schema.ListNestedBlock{
+				MarkdownDescription: "Assertions against the json response body, added to the assertions as `response.json \"<path>\" <operator> <value>`",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "The path of the value in the response body",
+							Required:            true,
+						},
+						"operator": schema.StringAttribute{
+							MarkdownDescription: "The comparison operator, one of `" + strings.Join(jsonAssertionOperators, "`, `") + "`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(jsonAssertionOperators...),
+							},
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value to compare against, quoted unless it is a number, boolean or null",
+							Required:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// UpgradeState migrates state saved with older versions of the schema.
+func (r *HttpMonitorResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// The request attributes moved into the request block
+		0: stateUpgrader(httpMonitorSchemaV0(ctx), upgradeHttpMonitorV0),
+	}
+}
+
 func (r *HttpMonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -200,6 +455,8 @@ func (r *HttpMonitorResource) Configure(ctx context.Context, req resource.Config
 }
 
 func (r *HttpMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
 	var data HttpMonitorModel
 
 	// Read Terraform plan data into the model
@@ -209,13 +466,53 @@ func (r *HttpMonitorResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	monitor, err := r.client.CreateMonitor(ctx, httpToMonitorRequest(data))
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	mon := httpToMonitorRequest(data)
+
+	monitor, err := r.client.CreateMonitor(ctx, mon)
+	if errors.Is(err, cronitor.ErrMonitorExists) && data.AdoptExisting.ValueBool() {
+		tflog.Info(ctx, "monitor already exists, adopting existing monitor", map[string]any{"error": err.Error()})
+		monitor, err = adoptMonitor(ctx, r.client, mon, err)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create monitor", apiErrorDetail(ctx, err))
+		return
+	}
+
+	monitor, err = getMonitorWithRetry(ctx, r.client, *monitor.Key)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to create monitor", err.Error())
+		resp.Diagnostics.AddError("failed to get created monitor", apiErrorDetail(ctx, err))
+		return
+	}
+
+	if err := snoozeMonitor(ctx, r.client, *monitor.Key, data.SnoozeUntil); err != nil {
+		resp.Diagnostics.AddError("failed to snooze monitor", apiErrorDetail(ctx, err))
 		return
 	}
 
 	data.Key = types.StringValue(*monitor.Key)
+	data.Note, data.RunbookUrl = splitRunbookNote(stringValue(monitor.Note), data.RunbookUrl)
+	data.Schedule = types.StringValue(monitor.Schedule)
+	data.GraceSeconds = optionalInt32(monitor.GraceSeconds, data.GraceSeconds, false)
+	data.Position = int32Value(monitor.Position)
+	data.FailureTolerance = optionalInt32(monitor.FailureTolerance, data.FailureTolerance, false)
+	data.ScheduleTolerance = optionalInt32(monitor.ScheduleTolerance, data.ScheduleTolerance, false)
+	data.DashboardUrl = types.StringValue(dashboardUrl(*monitor.Key))
+	data.setInherited(monitor, data.BaseMonitorModel)
+	data.Passing = types.BoolValue(monitor.Passing)
+	data.Running = types.BoolValue(monitor.Running)
+	data.Initialized = types.BoolValue(monitor.Initialized)
+	if data.Environments.IsUnknown() {
+		data.Environments = unorderedStringList(monitor.Environments)
+	}
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -226,6 +523,8 @@ func (r *HttpMonitorResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *HttpMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
 	var data HttpMonitorModel
 
 	// Read Terraform prior state data into the model
@@ -235,26 +534,46 @@ func (r *HttpMonitorResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	state := httpToMonitorRequest(data)
-
-	monitor, err := r.client.GetMonitor(ctx, data.Key.ValueString())
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	var monitor *cronitor.Monitor
+	var err error
+	if data.Name.IsNull() {
+		// Only the key is set after an import, which could be of a monitor
+		// that was created moments ago
+		monitor, err = getMonitorWithRetry(ctx, r.client, data.Key.ValueString())
+	} else {
+		monitor, err = r.client.GetMonitorBatched(ctx, data.Key.ValueString())
+	}
+	if errors.Is(err, cronitor.ErrNotFound) {
+		tflog.Warn(ctx, "monitor no longer exists, removing from state", map[string]any{"key": data.Key.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if keepStateOffline(r.client, err, &resp.Diagnostics) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("failed to get monitor from api", err.Error())
+		resp.Diagnostics.AddError("failed to get monitor from api", apiErrorDetail(ctx, err))
 		return
 	}
 
-	fixSliceOrder(state.Assertions, &monitor.Assertions)
-	fixSliceOrder(state.Environments, &monitor.Environments)
-	fixSliceOrder(state.Tags, &monitor.Tags)
-	fixSliceOrder(state.Request.Regions, &monitor.Request.Regions)
-
-	data = toHttpMonitor(monitor)
+	data = toHttpMonitor(monitor, data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *HttpMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
 	var state HttpMonitorModel
 	var plan HttpMonitorModel
 
@@ -266,26 +585,38 @@ func (r *HttpMonitorResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
 	upd := httpToMonitorRequest(plan)
 	upd.Key = state.Key.ValueStringPointer()
-	monitor, err := r.client.UpdateMonitor(ctx, upd)
+	monitor, err := updateMonitor(ctx, r.client, httpToMonitorRequest(state), upd)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to update http monitor", err.Error())
+		resp.Diagnostics.AddError("failed to update http monitor", apiErrorDetail(ctx, err))
 		return
 	}
 
-	fixSliceOrder(upd.Assertions, &monitor.Assertions)
-	fixSliceOrder(upd.Environments, &monitor.Environments)
-	fixSliceOrder(upd.Tags, &monitor.Tags)
-	fixSliceOrder(upd.Request.Regions, &monitor.Request.Regions)
+	// The update can clear the pause, so the snooze is always reapplied
+	if err := snoozeMonitor(ctx, r.client, *monitor.Key, plan.SnoozeUntil); err != nil {
+		resp.Diagnostics.AddError("failed to snooze monitor", apiErrorDetail(ctx, err))
+		return
+	}
 
-	state = toHttpMonitor(monitor)
+	state = toHttpMonitor(monitor, plan)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *HttpMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
 	var data HttpMonitorModel
 
 	// Read Terraform prior state data into the model
@@ -295,14 +626,59 @@ func (r *HttpMonitorResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	if err := r.client.DeleteMonitor(ctx, data.Key.ValueString()); err != nil {
-		resp.Diagnostics.AddError("failed to delete record", err.Error())
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deletion_protection"),
+			"deletion protection enabled",
+			fmt.Sprintf("monitor %s has deletion_protection enabled, set it to false and apply before destroying it", data.Key.ValueString()),
+		)
+		return
+	}
+
+	if data.PauseOnDestroy.ValueBool() {
+		if err := r.client.PauseMonitor(ctx, data.Key.ValueString(), 0); err != nil {
+			resp.Diagnostics.AddError("failed to pause monitor", apiErrorDetail(ctx, err))
+		}
+		return
+	}
+
+	if data.StatusPageProtection.ValueBool() && !canDeleteFromStatusPages(ctx, r.client, data.Key.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	// Something else has already deleted it, which is what we wanted anyway
+	if err := r.client.DeleteMonitorBatched(ctx, data.Key.ValueString()); err != nil && !errors.Is(err, cronitor.ErrNotFound) {
+		resp.Diagnostics.AddError("failed to delete record", apiErrorDetail(ctx, err))
 		return
 	}
 }
 
+// ImportState imports the monitor by its key, or by its name when the id is in
+// the form name=<name>.
 func (r *HttpMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	importMonitor(ctx, r.client, "check", req, resp)
+}
+
+func (r *HttpMonitorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		checkAssertionsValidator{},
+		checkScheduleValidator{},
+		requestBodyValidator{},
+		bearerTokenValidator{},
+		maxRedirectsValidator{},
+		deprecationValidator{deprecations: httpMonitorDeprecations},
+	}
 }
 
 func (r *HttpMonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -315,17 +691,60 @@ func (r *HttpMonitorResource) ValidateConfig(ctx context.Context, req resource.V
 	}
 
 	mon := httpToMonitorRequest(data)
+	request, _ := toHttpRequest(data.Request)
+
+	validateScheduleSpec(data.ScheduleSpec, &resp.Diagnostics)
+	validateRealertInterval(data.RealertInterval, &resp.Diagnostics)
+	validateEnvironmentOverrides(data.EnvironmentOverrides, data.Environments, &resp.Diagnostics)
+
+	if !data.SnoozeUntil.IsNull() && !data.SnoozeUntil.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, data.SnoozeUntil.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("snooze_until"), "invalid snooze_until", err.Error())
+		}
+	}
 
 	for key := range mon.Request.Headers {
 		if key != strings.ToLower(key) {
 			resp.Diagnostics.AddError("header keys must be in lower case", key)
 		}
 	}
+	headers := toStringMap(request.Headers)
+	for key := range toStringListMap(data.HeadersMulti) {
+		if _, ok := headers[key]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("headers_multi"), "duplicate header", fmt.Sprintf("%s cannot be set in both headers and headers_multi", key))
+		}
+	}
 	for key := range mon.Request.Cookies {
 		if key != strings.ToLower(key) {
 			resp.Diagnostics.AddError("cookie keys must be in lower case", key)
 		}
 	}
+	for _, attr := range []struct {
+		name string
+		val  types.String
+	}{{"client_cert_pem", request.ClientCertPem}, {"client_key_pem", request.ClientKeyPem}} {
+		if attr.val.IsNull() || attr.val.IsUnknown() {
+			continue
+		}
+		if block, _ := pem.Decode([]byte(attr.val.ValueString())); block == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("request").AtName(attr.name), "invalid pem", fmt.Sprintf("%s must be pem encoded", attr.name))
+		}
+	}
+	if !data.Graphql.IsNull() && !data.Graphql.IsUnknown() {
+		g, _ := toGraphqlModel(data.Graphql)
+		if g.Query.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("graphql").AtName("query"), "missing graphql query", "query must be set when using a graphql block")
+		}
+		if !request.Body.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("request").AtName("body"), "conflicting request body", "body cannot be used alongside a graphql block")
+		}
+		if !request.Method.IsUnknown() && request.Method.ValueString() != http.MethodPost {
+			resp.Diagnostics.AddAttributeError(path.Root("request").AtName("method"), "invalid graphql method", "method must be POST when using a graphql block")
+		}
+		if !g.Variables.IsNull() && !g.Variables.IsUnknown() && !json.Valid([]byte(g.Variables.ValueString())) {
+			resp.Diagnostics.AddAttributeError(path.Root("graphql").AtName("variables"), "invalid graphql variables", "variables must be valid json")
+		}
+	}
 
 	// if err := data.validate(); err != nil {
 	// 	resp.Diagnostics.AddError("monitor failed validation", err.Error())
@@ -0,0 +1,279 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HttpMonitorModelV0 is the http monitor model before the request attributes
+// moved into the request block.
+type HttpMonitorModelV0 struct {
+	Key                      types.String   `tfsdk:"key"`
+	Name                     types.String   `tfsdk:"name"`
+	Disabled                 types.Bool     `tfsdk:"disabled"`
+	Paused                   types.Bool     `tfsdk:"paused"`
+	Schedule                 types.String   `tfsdk:"schedule"`
+	ScheduleSpec             types.Object   `tfsdk:"schedule_spec"`
+	Notify                   types.List     `tfsdk:"notify"`
+	ScheduleTolerance        types.Int32    `tfsdk:"schedule_tolerance"`
+	FailureTolerance         types.Int32    `tfsdk:"failure_tolerance"`
+	GraceSeconds             types.Int32    `tfsdk:"grace_seconds"`
+	RealertInterval          types.String   `tfsdk:"realert_interval"`
+	Timezone                 types.String   `tfsdk:"timezone"`
+	Tags                     types.List     `tfsdk:"tags"`
+	Environments             types.List     `tfsdk:"environments"`
+	Group                    types.String   `tfsdk:"group"`
+	Position                 types.Int32    `tfsdk:"position"`
+	Note                     types.String   `tfsdk:"note"`
+	RunbookUrl               types.String   `tfsdk:"runbook_url"`
+	Passing                  types.Bool     `tfsdk:"passing"`
+	Running                  types.Bool     `tfsdk:"running"`
+	Initialized              types.Bool     `tfsdk:"initialized"`
+	DashboardUrl             types.String   `tfsdk:"dashboard_url"`
+	SnoozeUntil              types.String   `tfsdk:"snooze_until"`
+	PauseOnDestroy           types.Bool     `tfsdk:"pause_on_destroy"`
+	DeletionProtection       types.Bool     `tfsdk:"deletion_protection"`
+	AdoptExisting            types.Bool     `tfsdk:"adopt_existing"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+	EnvironmentOverrides     types.List     `tfsdk:"environment_overrides"`
+	Escalations              types.List     `tfsdk:"escalation"`
+	EffectiveNotify          types.List     `tfsdk:"effective_notify"`
+	EffectiveTags            types.List     `tfsdk:"effective_tags"`
+	EffectiveRealertInterval types.String   `tfsdk:"effective_realert_interval"`
+
+	Url                types.String `tfsdk:"url"`
+	Headers            types.Map    `tfsdk:"headers"`
+	HeadersMulti       types.Map    `tfsdk:"headers_multi"`
+	Cookies            types.Map    `tfsdk:"cookies"`
+	Body               types.String `tfsdk:"body"`
+	Method             types.String `tfsdk:"method"`
+	TimeoutSeconds     types.Int32  `tfsdk:"timeout_seconds"`
+	Regions            types.List   `tfsdk:"regions"`
+	FollowRedirects    types.Bool   `tfsdk:"follow_redirects"`
+	MaxRedirects       types.Int32  `tfsdk:"max_redirects"`
+	VerifySsl          types.Bool   `tfsdk:"verify_ssl"`
+	ClientCertPem      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPem       types.String `tfsdk:"client_key_pem"`
+	IPVersion          types.String `tfsdk:"ip_version"`
+	Assertions         types.List   `tfsdk:"assertions"`
+	BearerToken        types.String `tfsdk:"bearer_token"`
+	ExpectedStatusCode types.Int32  `tfsdk:"expected_status_code"`
+	MaxResponseTimeMs  types.Int32  `tfsdk:"max_response_time_ms"`
+	SslExpiresWithin   types.Int32  `tfsdk:"ssl_expires_within_days"`
+	JsonAssertions     types.List   `tfsdk:"json_assertion"`
+	HeaderAssertions   types.List   `tfsdk:"header_assertion"`
+	Graphql            types.Object `tfsdk:"graphql"`
+}
+
+// httpMonitorSchemaV0 is the http monitor schema before the request attributes
+// moved into the request block. Only the types are needed to read the state.
+func httpMonitorSchemaV0(ctx context.Context) schema.Schema {
+	stringList := types.ListType{ElemType: types.StringType}
+
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key":                        schema.StringAttribute{Optional: true, Computed: true},
+			"dashboard_url":              schema.StringAttribute{Computed: true},
+			"passing":                    schema.BoolAttribute{Computed: true},
+			"running":                    schema.BoolAttribute{Computed: true},
+			"initialized":                schema.BoolAttribute{Computed: true},
+			"name":                       schema.StringAttribute{Required: true},
+			"assertions":                 schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"expected_status_code":       schema.Int32Attribute{Optional: true},
+			"max_response_time_ms":       schema.Int32Attribute{Optional: true},
+			"ssl_expires_within_days":    schema.Int32Attribute{Optional: true},
+			"disabled":                   schema.BoolAttribute{Optional: true, Computed: true},
+			"failure_tolerance":          schema.Int32Attribute{Optional: true, Computed: true},
+			"grace_seconds":              schema.Int32Attribute{Optional: true, Computed: true},
+			"paused":                     schema.BoolAttribute{Optional: true, Computed: true},
+			"snooze_until":               schema.StringAttribute{Optional: true},
+			"adopt_existing":             schema.BoolAttribute{Optional: true, Computed: true},
+			"deletion_protection":        schema.BoolAttribute{Optional: true, Computed: true},
+			"pause_on_destroy":           schema.BoolAttribute{Optional: true, Computed: true},
+			"realert_interval":           schema.StringAttribute{Optional: true},
+			"effective_notify":           schema.ListAttribute{ElementType: types.StringType, Computed: true},
+			"effective_tags":             schema.ListAttribute{ElementType: types.StringType, Computed: true},
+			"effective_realert_interval": schema.StringAttribute{Computed: true},
+			"url":                        schema.StringAttribute{Required: true},
+			"headers":                    schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"headers_multi":              schema.MapAttribute{ElementType: stringList, Optional: true},
+			"cookies":                    schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"bearer_token":               schema.StringAttribute{Optional: true, Sensitive: true},
+			"body":                       schema.StringAttribute{Optional: true},
+			"method":                     schema.StringAttribute{Required: true},
+			"timeout_seconds":            schema.Int32Attribute{Optional: true, Computed: true},
+			"regions":                    schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"follow_redirects":           schema.BoolAttribute{Optional: true, Computed: true},
+			"client_cert_pem":            schema.StringAttribute{Optional: true, Sensitive: true},
+			"client_key_pem":             schema.StringAttribute{Optional: true, Sensitive: true},
+			"ip_version":                 schema.StringAttribute{Optional: true, Computed: true},
+			"max_redirects":              schema.Int32Attribute{Optional: true},
+			"verify_ssl":                 schema.BoolAttribute{Optional: true, Computed: true},
+			"schedule":                   schema.StringAttribute{Optional: true, Computed: true},
+			"schedule_spec": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"type":       schema.StringAttribute{Required: true},
+					"seconds":    schema.Int32Attribute{Optional: true},
+					"expression": schema.StringAttribute{Optional: true},
+				},
+			},
+			"schedule_tolerance": schema.Int32Attribute{Optional: true, Computed: true},
+			"tags":               schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"timezone":           schema.StringAttribute{Optional: true},
+			"notify":             schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"environments":       schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"note":               schema.StringAttribute{Optional: true, Computed: true},
+			"runbook_url":        schema.StringAttribute{Optional: true},
+			"group":              schema.StringAttribute{Optional: true},
+			"position":           schema.Int32Attribute{Optional: true, Computed: true},
+		},
+		Blocks: map[string]schema.Block{
+			"environment_overrides": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"environment": schema.StringAttribute{Required: true},
+						"schedule":    schema.StringAttribute{Optional: true},
+						"notify":      schema.ListAttribute{ElementType: types.StringType, Optional: true},
+						"assertions":  schema.ListAttribute{ElementType: types.StringType, Optional: true},
+					},
+				},
+			},
+			"escalation": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"notify":        schema.ListAttribute{ElementType: types.StringType, Required: true},
+						"after_alerts":  schema.Int32Attribute{Optional: true},
+						"after_minutes": schema.Int32Attribute{Optional: true},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"graphql": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"query":     schema.StringAttribute{Optional: true},
+					"variables": schema.StringAttribute{Optional: true},
+				},
+			},
+			"header_assertion": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name":     schema.StringAttribute{Required: true},
+						"operator": schema.StringAttribute{Required: true},
+						"value":    schema.StringAttribute{Required: true},
+					},
+				},
+			},
+			"json_assertion": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"path":     schema.StringAttribute{Required: true},
+						"operator": schema.StringAttribute{Required: true},
+						"value":    schema.StringAttribute{Required: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func upgradeHttpMonitorV0(ctx context.Context, in HttpMonitorModelV0) (HttpMonitorModel, diag.Diagnostics) {
+	out := HttpMonitorModel{
+		BaseMonitorModel: BaseMonitorModel{
+			Key:                      in.Key,
+			Name:                     in.Name,
+			Disabled:                 in.Disabled,
+			Paused:                   in.Paused,
+			Schedule:                 in.Schedule,
+			ScheduleSpec:             in.ScheduleSpec,
+			Notify:                   UnorderedListValue{ListValue: in.Notify},
+			ScheduleTolerance:        in.ScheduleTolerance,
+			FailureTolerance:         in.FailureTolerance,
+			GraceSeconds:             in.GraceSeconds,
+			RealertInterval:          in.RealertInterval,
+			Timezone:                 in.Timezone,
+			Tags:                     TagListValue{ListValue: in.Tags},
+			Environments:             UnorderedListValue{ListValue: in.Environments},
+			Group:                    in.Group,
+			Position:                 in.Position,
+			Note:                     in.Note,
+			RunbookUrl:               in.RunbookUrl,
+			Passing:                  in.Passing,
+			Running:                  in.Running,
+			Initialized:              in.Initialized,
+			DashboardUrl:             in.DashboardUrl,
+			SnoozeUntil:              in.SnoozeUntil,
+			PauseOnDestroy:           in.PauseOnDestroy,
+			DeletionProtection:       in.DeletionProtection,
+			StatusPageProtection:     types.BoolValue(false),
+			AdoptExisting:            in.AdoptExisting,
+			Timeouts:                 in.Timeouts,
+			EnvironmentOverrides:     retypeList(ctx, in.EnvironmentOverrides, environmentOverrideType),
+			Escalations:              retypeList(ctx, in.Escalations, escalationType),
+			EffectiveNotify:          in.EffectiveNotify,
+			EffectiveTags:            in.EffectiveTags,
+			EffectiveRealertInterval: in.EffectiveRealertInterval,
+		},
+		HeadersMulti:        in.HeadersMulti,
+		Assertions:          UnorderedListValue{ListValue: in.Assertions},
+		BearerToken:         in.BearerToken,
+		ExpectedStatusCode:  in.ExpectedStatusCode,
+		ExpectedStatusCodes: types.ListNull(types.Int32Type),
+		MaxResponseTimeMs:   in.MaxResponseTimeMs,
+		SslExpiresWithin:    in.SslExpiresWithin,
+		JsonAssertions:      in.JsonAssertions,
+		HeaderAssertions:    in.HeaderAssertions,
+		Graphql:             in.Graphql,
+	}
+
+	var diags diag.Diagnostics
+	out.Request, diags = types.ObjectValueFrom(ctx, httpRequestType.AttrTypes, HttpRequestModel{
+		Url:             in.Url,
+		Method:          in.Method,
+		Headers:         in.Headers,
+		Cookies:         in.Cookies,
+		Body:            RequestBodyValue{StringValue: in.Body},
+		TimeoutSeconds:  in.TimeoutSeconds,
+		Regions:         UnorderedListValue{ListValue: in.Regions},
+		FollowRedirects: in.FollowRedirects,
+		MaxRedirects:    in.MaxRedirects,
+		VerifySsl:       in.VerifySsl,
+		ClientCertPem:   in.ClientCertPem,
+		ClientKeyPem:    in.ClientKeyPem,
+		IPVersion:       in.IPVersion,
+	})
+	return out, diags
+}
+
+// retypeList converts a list to the element type the current schema uses for
+// it, which can differ from the old one where it has custom list types.
+func retypeList(ctx context.Context, in types.List, elemType attr.Type) types.List {
+	if in.IsNull() {
+		return types.ListNull(elemType)
+	}
+	if in.IsUnknown() {
+		return types.ListUnknown(elemType)
+	}
+	val, err := in.ToTerraformValue(ctx)
+	if err != nil {
+		return in
+	}
+	out, err := types.ListType{ElemType: elemType}.ValueFromTerraform(ctx, val)
+	if err != nil {
+		return in
+	}
+	return out.(types.List)
+}
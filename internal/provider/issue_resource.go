@@ -0,0 +1,299 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IssueResource{}
+var _ resource.ResourceWithImportState = &IssueResource{}
+
+const issueSeverityOutage = "outage"
+
+var issueStates = []string{"unresolved", "investigating", "identified", "monitoring", "resolved"}
+
+var issueSeverities = []string{issueSeverityOutage, "degraded_performance", "maintenance"}
+
+func NewIssueResource() resource.Resource {
+	return &IssueResource{}
+}
+
+// IssueResource defines the resource implementation.
+type IssueResource struct {
+	client *cronitor.Client
+}
+
+func (r *IssueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue"
+}
+
+func (r *IssueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Issue resource",
+
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The issue id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The issue title",
+				Required:            true,
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "The issue description",
+				Optional:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The issue state, one of `%s`. Changes made outside of terraform, such as the issue being auto resolved, are kept when this isn't set", strings.Join(issueStates, "`, `")),
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(issueStates...),
+				},
+			},
+			"severity": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The issue severity, one of `%s`", strings.Join(issueSeverities, "`, `")),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(issueSeverityOutage),
+				Validators: []validator.String{
+					stringvalidator.OneOf(issueSeverities...),
+				},
+			},
+			"monitors": schema.ListAttribute{
+				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
+				MarkdownDescription: "The keys of the monitors affected by the issue",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"auto_resolve": schema.BoolAttribute{
+				MarkdownDescription: "Whether the issue is resolved once all of the linked monitors have recovered. Requires `monitors`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *IssueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cronitor.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cronitor.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IssueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data IssueModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	issue, err := r.client.CreateIssue(ctx, issueToIssueRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create issue", apiErrorDetail(ctx, err))
+		return
+	}
+
+	data = toIssue(issue, data)
+
+	tflog.Trace(ctx, "created an issue")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data IssueModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	issue, err := r.client.GetIssue(ctx, data.Key.ValueString())
+	if errors.Is(err, cronitor.ErrNotFound) {
+		tflog.Warn(ctx, "issue no longer exists, removing from state", map[string]any{"key": data.Key.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if keepStateOffline(r.client, err, &resp.Diagnostics) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get issue from api", apiErrorDetail(ctx, err))
+		return
+	}
+
+	data = toIssue(issue, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var state IssueModel
+	var plan IssueModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	upd := issueToIssueRequest(plan)
+	upd.Key = state.Key.ValueString()
+	issue, err := r.client.UpdateIssue(ctx, upd)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update issue", apiErrorDetail(ctx, err))
+		return
+	}
+
+	state = toIssue(issue, plan)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *IssueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data IssueModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	// Something else has already deleted it, which is what we wanted anyway
+	if err := r.client.DeleteIssue(ctx, data.Key.ValueString()); err != nil && !errors.Is(err, cronitor.ErrNotFound) {
+		resp.Diagnostics.AddError("failed to delete issue", apiErrorDetail(ctx, err))
+		return
+	}
+}
+
+func (r *IssueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+}
+
+func (r *IssueResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IssueModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AutoResolve.ValueBool() && data.Monitors.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auto_resolve"),
+			"missing monitors",
+			"auto_resolve can only be used when monitors are linked to the issue",
+		)
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = NormalizedJSONType{}
+	_ basetypes.StringValuableWithSemanticEquals = NormalizedJSONValue{}
+	_ xattr.ValidateableAttribute                = NormalizedJSONValue{}
+)
+
+// NormalizedJSONType is a string holding a json document. Values are equal when
+// they hold the same json, so the api reformatting them doesn't cause a diff.
+type NormalizedJSONType struct {
+	basetypes.StringType
+}
+
+func (t NormalizedJSONType) Equal(o attr.Type) bool {
+	_, ok := o.(NormalizedJSONType)
+	return ok
+}
+
+func (t NormalizedJSONType) String() string {
+	return "NormalizedJSONType"
+}
+
+func (t NormalizedJSONType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return NormalizedJSONValue{StringValue: in}, nil
+}
+
+func (t NormalizedJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	val, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	str, ok := val.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", val)
+	}
+	return NormalizedJSONValue{StringValue: str}, nil
+}
+
+func (t NormalizedJSONType) ValueType(ctx context.Context) attr.Value {
+	return NormalizedJSONValue{}
+}
+
+// NormalizedJSONValue is the value of a NormalizedJSONType.
+type NormalizedJSONValue struct {
+	basetypes.StringValue
+}
+
+func (v NormalizedJSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(NormalizedJSONValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v NormalizedJSONValue) Type(ctx context.Context) attr.Type {
+	return NormalizedJSONType{}
+}
+
+func (v NormalizedJSONValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	other, ok := newValuable.(NormalizedJSONValue)
+	if !ok {
+		return false, nil
+	}
+	return jsonEqual([]byte(v.ValueString()), []byte(other.ValueString())), nil
+}
+
+func (v NormalizedJSONValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	var out any
+	if err := json.Unmarshal([]byte(v.ValueString()), &out); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "invalid json", fmt.Sprintf("value must be valid json: %s", err))
+	}
+}
+
+// NewNormalizedJSONValue returns the json, or null when it is empty.
+func NewNormalizedJSONValue(in string) NormalizedJSONValue {
+	if in == "" {
+		return NormalizedJSONValue{StringValue: types.StringNull()}
+	}
+	return NormalizedJSONValue{StringValue: types.StringValue(in)}
+}
+
+var (
+	_ basetypes.StringTypable                    = RequestBodyType{}
+	_ basetypes.StringValuableWithSemanticEquals = RequestBodyValue{}
+)
+
+// RequestBodyType is the body of a request, which can be any text. Values that
+// both hold json are equal when they hold the same json, like NormalizedJSONType,
+// but other bodies are left as they are and aren't validated.
+type RequestBodyType struct {
+	basetypes.StringType
+}
+
+func (t RequestBodyType) Equal(o attr.Type) bool {
+	_, ok := o.(RequestBodyType)
+	return ok
+}
+
+func (t RequestBodyType) String() string {
+	return "RequestBodyType"
+}
+
+func (t RequestBodyType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return RequestBodyValue{StringValue: in}, nil
+}
+
+func (t RequestBodyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	val, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	str, ok := val.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", val)
+	}
+	return RequestBodyValue{StringValue: str}, nil
+}
+
+func (t RequestBodyType) ValueType(ctx context.Context) attr.Value {
+	return RequestBodyValue{}
+}
+
+// RequestBodyValue is the value of a RequestBodyType.
+type RequestBodyValue struct {
+	basetypes.StringValue
+}
+
+func (v RequestBodyValue) Equal(o attr.Value) bool {
+	other, ok := o.(RequestBodyValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v RequestBodyValue) Type(ctx context.Context) attr.Type {
+	return RequestBodyType{}
+}
+
+// StringSemanticEquals reports whether both values hold the same json. Bodies
+// that aren't json are only equal when they are the same text, which the
+// framework already checks before asking.
+func (v RequestBodyValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	other, ok := newValuable.(RequestBodyValue)
+	if !ok {
+		return false, nil
+	}
+	return jsonEqual([]byte(v.ValueString()), []byte(other.ValueString())), nil
+}
+
+// NewRequestBodyValue returns the body, or null when it is empty.
+func NewRequestBodyValue(in string) RequestBodyValue {
+	if in == "" {
+		return RequestBodyValue{StringValue: types.StringNull()}
+	}
+	return RequestBodyValue{StringValue: types.StringValue(in)}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRequestBodySemanticEquals(t *testing.T) {
+	tcs := []struct {
+		name  string
+		a, b  string
+		equal bool
+	}{
+		{name: "reformatted json", a: `{"a": 1, "b": [1, 2]}`, b: `{"b":[1,2],"a":1}`, equal: true},
+		{name: "different json", a: `{"a": 1}`, b: `{"a": 2}`},
+		{name: "form encoded", a: "a=1&b=2", b: "b=2&a=1"},
+		{name: "json and text", a: `{"a": 1}`, b: "a=1"},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			equal, diags := NewRequestBodyValue(tc.a).StringSemanticEquals(context.Background(), NewRequestBodyValue(tc.b))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tc.equal {
+				t.Errorf("expected equal to be %t, got %t", tc.equal, equal)
+			}
+		})
+	}
+}
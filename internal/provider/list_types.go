@@ -0,0 +1,236 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The api doesn't keep the order of most lists, so comparing them in order
+// shows a diff whenever it returns them differently to how they were sent. The
+// list types here are semantically equal when they hold the same elements in
+// any order, so the framework keeps the prior value instead.
+
+var (
+	_ basetypes.ListTypable                    = UnorderedListType{}
+	_ basetypes.ListValuableWithSemanticEquals = UnorderedListValue{}
+	_ basetypes.ListTypable                    = TagListType{}
+	_ basetypes.ListValuableWithSemanticEquals = TagListValue{}
+)
+
+// UnorderedListType is a list where the order of the elements doesn't matter.
+type UnorderedListType struct {
+	basetypes.ListType
+}
+
+func (t UnorderedListType) Equal(o attr.Type) bool {
+	other, ok := o.(UnorderedListType)
+	if !ok {
+		return false
+	}
+	return t.ListType.Equal(other.ListType)
+}
+
+func (t UnorderedListType) String() string {
+	return fmt.Sprintf("UnorderedListType[%s]", t.ElemType)
+}
+
+func (t UnorderedListType) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return UnorderedListValue{ListValue: in}, nil
+}
+
+func (t UnorderedListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	val, err := t.ListType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := val.(basetypes.ListValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", val)
+	}
+	return UnorderedListValue{ListValue: list}, nil
+}
+
+func (t UnorderedListType) ValueType(ctx context.Context) attr.Value {
+	return UnorderedListValue{}
+}
+
+// UnorderedListValue is the value of an UnorderedListType.
+type UnorderedListValue struct {
+	basetypes.ListValue
+}
+
+func (v UnorderedListValue) Equal(o attr.Value) bool {
+	other, ok := o.(UnorderedListValue)
+	if !ok {
+		return false
+	}
+	return v.ListValue.Equal(other.ListValue)
+}
+
+func (v UnorderedListValue) Type(ctx context.Context) attr.Type {
+	return UnorderedListType{ListType: basetypes.ListType{ElemType: v.ElementType(ctx)}}
+}
+
+func (v UnorderedListValue) ListSemanticEquals(ctx context.Context, newValuable basetypes.ListValuable) (bool, diag.Diagnostics) {
+	other, ok := newValuable.(UnorderedListValue)
+	if !ok {
+		return false, nil
+	}
+	return sameElements(v.ListValue, other.ListValue, attr.Value.Equal), nil
+}
+
+// TagListType is a list of tags, which are compared ignoring their order, case,
+// surrounding whitespace and duplicates as the api doesn't keep any of them.
+type TagListType struct {
+	basetypes.ListType
+}
+
+func (t TagListType) Equal(o attr.Type) bool {
+	other, ok := o.(TagListType)
+	if !ok {
+		return false
+	}
+	return t.ListType.Equal(other.ListType)
+}
+
+func (t TagListType) String() string {
+	return "TagListType"
+}
+
+func (t TagListType) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return TagListValue{ListValue: in}, nil
+}
+
+func (t TagListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	val, err := t.ListType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := val.(basetypes.ListValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", val)
+	}
+	return TagListValue{ListValue: list}, nil
+}
+
+func (t TagListType) ValueType(ctx context.Context) attr.Value {
+	return TagListValue{}
+}
+
+// TagListValue is the value of a TagListType.
+type TagListValue struct {
+	basetypes.ListValue
+}
+
+func (v TagListValue) Equal(o attr.Value) bool {
+	other, ok := o.(TagListValue)
+	if !ok {
+		return false
+	}
+	return v.ListValue.Equal(other.ListValue)
+}
+
+func (v TagListValue) Type(ctx context.Context) attr.Type {
+	return tagListType
+}
+
+func (v TagListValue) ListSemanticEquals(ctx context.Context, newValuable basetypes.ListValuable) (bool, diag.Diagnostics) {
+	other, ok := newValuable.(TagListValue)
+	if !ok {
+		return false, nil
+	}
+	a, aok := tagSet(v.ListValue)
+	b, bok := tagSet(other.ListValue)
+	return aok && bok && maps.Equal(a, b), nil
+}
+
+// Tags returns the tags to send to the api, trimmed and with duplicates that
+// only differ in case removed, keeping the first of them.
+func (v TagListValue) Tags() []string {
+	out := []string{}
+	seen := map[string]bool{}
+	for _, t := range toStringSlice(v) {
+		t = strings.TrimSpace(t)
+		if seen[normalizeTag(t)] {
+			continue
+		}
+		seen[normalizeTag(t)] = true
+		out = append(out, t)
+	}
+	return out
+}
+
+func normalizeTag(t string) string {
+	return strings.ToLower(strings.TrimSpace(t))
+}
+
+// tagSet returns the normalized tags in the list, and whether they are all
+// known.
+func tagSet(l basetypes.ListValue) (map[string]bool, bool) {
+	if l.IsNull() || l.IsUnknown() {
+		return nil, false
+	}
+	out := map[string]bool{}
+	for _, e := range l.Elements() {
+		s, ok := e.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			return nil, false
+		}
+		out[normalizeTag(s.ValueString())] = true
+	}
+	return out, true
+}
+
+var (
+	unorderedStringListType = UnorderedListType{ListType: types.ListType{ElemType: types.StringType}}
+	tagListType             = TagListType{ListType: types.ListType{ElemType: types.StringType}}
+)
+
+// sameElements reports whether both lists are known and hold the same elements,
+// in any order.
+func sameElements(a, b basetypes.ListValue, equal func(a, b attr.Value) bool) bool {
+	if a.IsNull() || a.IsUnknown() || b.IsNull() || b.IsUnknown() {
+		return false
+	}
+	ae, be := a.Elements(), b.Elements()
+	if len(ae) != len(be) {
+		return false
+	}
+	used := make([]bool, len(be))
+	for _, x := range ae {
+		found := false
+		for i, y := range be {
+			if !used[i] && equal(x, y) {
+				used[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func unorderedStringList(in []string) UnorderedListValue {
+	return UnorderedListValue{ListValue: stringSlice(in)}
+}
+
+func unorderedStringListNull() UnorderedListValue {
+	return UnorderedListValue{ListValue: types.ListNull(types.StringType)}
+}
+
+func tagList(in []string) TagListValue {
+	return TagListValue{ListValue: stringSlice(in)}
+}
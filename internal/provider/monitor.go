@@ -0,0 +1,185 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+const (
+	monitorRetryAttempts = 5
+	monitorRetryInterval = 500 * time.Millisecond
+)
+
+// getMonitorWithRetry gets the monitor, retrying with backoff while the api
+// reports that it doesn't exist, as monitors aren't always readable straight
+// after they have been created.
+func getMonitorWithRetry(ctx context.Context, c *cronitor.Client, key string) (*cronitor.Monitor, error) {
+	wait := monitorRetryInterval
+	for attempt := 1; ; attempt++ {
+		mon, err := c.GetMonitor(ctx, key)
+		if !errors.Is(err, cronitor.ErrNotFound) || attempt == monitorRetryAttempts {
+			return mon, err
+		}
+		if err := c.WaitToRetry(ctx, wait); err != nil {
+			return nil, err
+		}
+		wait *= 2
+	}
+}
+
+// updateMonitor applies the changes between the prior and updated monitor,
+// sending only the fields that changed. The pause state is changed through the
+// pause endpoint as a plain update doesn't reliably change it, and the update
+// itself is skipped when nothing else has changed. The monitor is only fetched
+// again when the update didn't return it or it was paused afterwards.
+func updateMonitor(ctx context.Context, c *cronitor.Client, prior, upd *cronitor.Monitor) (*cronitor.Monitor, error) {
+	unpaused := *prior
+	unpaused.Key = upd.Key
+	unpaused.Paused = upd.Paused
+
+	var mon *cronitor.Monitor
+	if !reflect.DeepEqual(&unpaused, upd) {
+		var err error
+		if mon, err = c.UpdateMonitorChanges(ctx, &unpaused, upd); err != nil {
+			return nil, err
+		}
+	}
+
+	if prior.Paused != upd.Paused {
+		var err error
+		if upd.Paused {
+			err = c.PauseMonitor(ctx, *upd.Key, 0)
+		} else {
+			err = c.UnpauseMonitor(ctx, *upd.Key)
+		}
+		if err != nil {
+			return nil, err
+		}
+		mon = nil
+	}
+
+	if mon == nil {
+		return c.GetMonitor(ctx, *upd.Key)
+	}
+	return mon, nil
+}
+
+// adoptMonitor finds an existing monitor with the same key, or name when no key
+// is set, and updates it to match. It is only used when creating the monitor
+// failed with createErr because it already exists, which is kept in the error
+// when the existing monitor can't be found.
+func adoptMonitor(ctx context.Context, c *cronitor.Client, mon *cronitor.Monitor, createErr error) (*cronitor.Monitor, error) {
+	var existing *cronitor.Monitor
+	var err error
+	if mon.Key != nil {
+		existing, err = c.GetMonitor(ctx, *mon.Key)
+	} else {
+		existing, err = c.FindMonitorByName(ctx, mon.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w, and failed to find existing monitor: %w", createErr, err)
+	}
+
+	mon.Key = existing.Key
+	return c.UpdateMonitor(ctx, mon)
+}
+
+// snoozeMonitor pauses the monitor until the snooze time, doing nothing when it
+// isn't set or has already passed.
+func snoozeMonitor(ctx context.Context, c *cronitor.Client, key string, until types.String) error {
+	t, ok := snoozeTime(until)
+	if !ok {
+		return nil
+	}
+	hours := int(math.Ceil(time.Until(t).Hours()))
+	if hours <= 0 {
+		return nil
+	}
+	return c.PauseMonitor(ctx, key, hours)
+}
+
+func snoozeTime(until types.String) (time.Time, bool) {
+	if until.IsNull() || until.IsUnknown() {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, until.ValueString())
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// snoozed reports whether the monitor is currently paused by snooze_until.
+func snoozed(until types.String) bool {
+	t, ok := snoozeTime(until)
+	return ok && time.Now().Before(t)
+}
+
+// canDeleteFromStatusPages reports whether the monitor isn't shown on any status
+// pages, adding an error naming the pages when it is.
+func canDeleteFromStatusPages(ctx context.Context, c *cronitor.Client, key string, diags *diag.Diagnostics) bool {
+	pages, err := c.ListStatusPages(ctx)
+	if err != nil {
+		diags.AddError("failed to list status pages", apiErrorDetail(ctx, err))
+		return false
+	}
+
+	showing := []string{}
+	for _, p := range pages {
+		for _, s := range p.Sections {
+			if slices.Contains(s.Monitors, key) {
+				showing = append(showing, p.Name)
+				break
+			}
+		}
+	}
+	if len(showing) == 0 {
+		return true
+	}
+
+	diags.AddAttributeError(
+		path.Root("status_page_protection"),
+		"monitor is on a status page",
+		fmt.Sprintf("monitor %s is shown on the status pages %s, remove it from them or set status_page_protection to false and apply before destroying it", key, strings.Join(showing, ", ")),
+	)
+	return false
+}
+
+// importMonitor imports the monitor by its key, or by its name when the id is
+// in the form name=<name>. Monitors found by name must be of the given type.
+func importMonitor(ctx context.Context, c *cronitor.Client, monitorType string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, ok := strings.CutPrefix(req.ID, "name=")
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+		return
+	}
+	ctx = c.WithOperation(ctx)
+
+	monitor, err := c.FindMonitorByName(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to find monitor", apiErrorDetail(ctx, err))
+		return
+	}
+	if monitor.Type != monitorType {
+		resp.Diagnostics.AddError("failed to find monitor", fmt.Sprintf("monitor %s is a %s monitor, not a %s monitor", name, monitor.Type, monitorType))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), monitor.Key)...)
+}
@@ -0,0 +1,221 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MonitorImportsDataSource{}
+
+// monitorResourceTypes maps monitor types to the resources that manage them.
+var monitorResourceTypes = map[string]string{
+	"check":     "cronitor_http_monitor",
+	"heartbeat": "cronitor_heartbeat_monitor",
+}
+
+var monitorImportType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"key":           types.StringType,
+		"name":          types.StringType,
+		"type":          types.StringType,
+		"resource_type": types.StringType,
+		"resource_name": types.StringType,
+	},
+}
+
+type MonitorImportsModel struct {
+	Tags         types.List   `tfsdk:"tags"`
+	Group        types.String `tfsdk:"group"`
+	UpdatedSince types.String `tfsdk:"updated_since"`
+	Monitors     types.List   `tfsdk:"monitors"`
+}
+
+type MonitorImportModel struct {
+	Key          types.String `tfsdk:"key"`
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceName types.String `tfsdk:"resource_name"`
+}
+
+func NewMonitorImportsDataSource() datasource.DataSource {
+	return &MonitorImportsDataSource{}
+}
+
+// MonitorImportsDataSource lists existing monitors in a form that can be used
+// to write import blocks for them.
+type MonitorImportsDataSource struct {
+	client *cronitor.Client
+}
+
+func (d *MonitorImportsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_imports"
+}
+
+func (d *MonitorImportsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists existing monitors with the resource address they can be imported to, for generating `import` blocks when adopting an account",
+
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only include monitors that have all of these tags",
+				Optional:            true,
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Only include monitors in this group",
+				Optional:            true,
+			},
+			"updated_since": schema.StringAttribute{
+				MarkdownDescription: "Only include monitors changed after this RFC3339 timestamp",
+				Optional:            true,
+			},
+			"monitors": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching monitors, ordered by `resource_name`",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The monitor id, used as the import id",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The monitor name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The monitor type",
+							Computed:            true,
+						},
+						"resource_type": schema.StringAttribute{
+							MarkdownDescription: "The resource that manages the monitor, null when there isn't one for its type",
+							Computed:            true,
+						},
+						"resource_name": schema.StringAttribute{
+							MarkdownDescription: "A resource name made from the monitor name, unique within the list",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MonitorImportsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cronitor.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *cronitor.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MonitorImportsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnThrottled(d.client, &resp.Diagnostics)
+	ctx = d.client.WithOperation(ctx)
+
+	var data MonitorImportsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := cronitor.ListMonitorsOptions{}
+	if !data.UpdatedSince.IsNull() {
+		since, err := time.Parse(time.RFC3339, data.UpdatedSince.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("updated_since"), "invalid updated_since", fmt.Sprintf("%q is not an RFC3339 timestamp", data.UpdatedSince.ValueString()))
+			return
+		}
+		opts.UpdatedSince = since
+	}
+
+	monitors, err := d.client.ListMonitorsCached(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list monitors", apiErrorDetail(ctx, err))
+		return
+	}
+
+	tags := toStringSlice(data.Tags)
+	matches := []MonitorImportModel{}
+	used := map[string]bool{}
+	for _, m := range monitors {
+		if !data.Group.IsNull() && stringValue(m.Group) != data.Group.ValueString() {
+			continue
+		}
+		if slices.ContainsFunc(tags, func(t string) bool { return !slices.Contains(m.Tags, t) }) {
+			continue
+		}
+
+		name := resourceName(m.Name)
+		for i := 2; used[name]; i++ {
+			name = fmt.Sprintf("%s_%d", resourceName(m.Name), i)
+		}
+		used[name] = true
+
+		resourceType := types.StringNull()
+		if t, ok := monitorResourceTypes[m.Type]; ok {
+			resourceType = types.StringValue(t)
+		}
+		matches = append(matches, MonitorImportModel{
+			Key:          types.StringValue(stringValue(m.Key)),
+			Name:         types.StringValue(m.Name),
+			Type:         types.StringValue(m.Type),
+			ResourceType: resourceType,
+			ResourceName: types.StringValue(name),
+		})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].ResourceName.ValueString() < matches[j].ResourceName.ValueString()
+	})
+
+	out, diags := types.ListValueFrom(ctx, monitorImportType, matches)
+	resp.Diagnostics.Append(diags...)
+	data.Monitors = out
+
+	tflog.Trace(ctx, "listed monitors to import", map[string]any{"count": len(matches)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resourceName converts a monitor name into a valid terraform resource name,
+// which can't start with a number.
+func resourceName(name string) string {
+	out := strings.ReplaceAll(cronitor.Slug(name), "-", "_")
+	if out == "" || (out[0] >= '0' && out[0] <= '9') {
+		out = "monitor_" + out
+	}
+	return strings.TrimSuffix(out, "_")
+}
@@ -0,0 +1,57 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &MonitorKeyFunction{}
+
+func NewMonitorKeyFunction() function.Function {
+	return &MonitorKeyFunction{}
+}
+
+// MonitorKeyFunction converts a name into a valid key.
+type MonitorKeyFunction struct{}
+
+func (f *MonitorKeyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "monitor_key"
+}
+
+func (f *MonitorKeyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Converts a name into a key",
+		MarkdownDescription: "Returns the name as a key that is valid for monitors and notification lists, lower casing it and replacing everything other than letters, numbers and underscores with dashes. For example `Nightly Backup (EU)` becomes `nightly-backup-eu`",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name to convert",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *MonitorKeyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	key := cronitor.Slug(name)
+	if key == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q doesn't contain any letters or numbers to build a key from", name))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, key))
+}
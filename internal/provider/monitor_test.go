@@ -0,0 +1,72 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+func TestAdoptMonitorKeepsCreateError(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(srv.Close)
+	c := cronitor.NewClient(cronitor.NewClientOpts{Endpoint: srv.URL, ApiKey: "test"})
+
+	key := "abc"
+	createErr := cronitor.ErrMonitorExists
+	_, err := adoptMonitor(context.Background(), c, &cronitor.Monitor{Key: &key, Name: "test"}, createErr)
+	if !errors.Is(err, createErr) {
+		t.Errorf("expected the create error to be kept, got %v", err)
+	}
+	if !errors.Is(err, cronitor.ErrNotFound) {
+		t.Errorf("expected the lookup error to be kept, got %v", err)
+	}
+}
+
+func TestUpdateMonitorRequests(t *testing.T) {
+	tcs := []struct {
+		name   string
+		upd    cronitor.Monitor
+		gets   int
+		pauses int
+	}{
+		{name: "changed", upd: cronitor.Monitor{Type: "heartbeat", Name: "renamed"}, gets: 1},
+		{name: "paused", upd: cronitor.Monitor{Type: "heartbeat", Name: "test", Paused: true}, gets: 1, pauses: 1},
+		{name: "changed and paused", upd: cronitor.Monitor{Type: "heartbeat", Name: "renamed", Paused: true}, gets: 2, pauses: 1},
+		{name: "unchanged", upd: cronitor.Monitor{Type: "heartbeat", Name: "test"}, gets: 1},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var gets, pauses int
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.Contains(r.URL.Path, "/pause"):
+					pauses++
+				case r.Method == http.MethodGet:
+					gets++
+				}
+				_, _ = w.Write([]byte(`{"key":"abc","type":"heartbeat"}`))
+			}))
+			t.Cleanup(srv.Close)
+			c := cronitor.NewClient(cronitor.NewClientOpts{Endpoint: srv.URL, ApiKey: "test"})
+
+			key := "abc"
+			prior := &cronitor.Monitor{Key: &key, Type: "heartbeat", Name: "test"}
+			upd := tc.upd
+			upd.Key = &key
+			if _, err := updateMonitor(context.Background(), c, prior, &upd); err != nil {
+				t.Fatalf("failed to update monitor: %s", err)
+			}
+			if gets != tc.gets || pauses != tc.pauses {
+				t.Errorf("expected %d gets and %d pauses, got %d and %d", tc.gets, tc.pauses, gets, pauses)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const maxNextRunTimes = 1000
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NextRunTimesFunction{}
+
+func NewNextRunTimesFunction() function.Function {
+	return &NextRunTimesFunction{}
+}
+
+// NextRunTimesFunction lists the upcoming runs of a cron schedule.
+type NextRunTimesFunction struct{}
+
+func (f *NextRunTimesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "next_run_times"
+}
+
+func (f *NextRunTimesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Lists the next run times of a cron schedule",
+		MarkdownDescription: "Returns the next `n` times a cron schedule runs as RFC3339 timestamps in the timezone. The times are counted from `from` when it is set, otherwise the current time. Pass `plantimestamp()` as `from` when the result is used in resource arguments, so that it doesn't change between plan and apply",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "schedule",
+				MarkdownDescription: "The cron schedule",
+			},
+			function.StringParameter{
+				Name:                "timezone",
+				MarkdownDescription: "The timezone the schedule runs in, such as `Europe/London`",
+			},
+			function.Int32Parameter{
+				Name:                "n",
+				MarkdownDescription: fmt.Sprintf("The number of run times to return, up to %d", maxNextRunTimes),
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "from",
+			MarkdownDescription: "The RFC3339 timestamp to count from, only the first value is used",
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *NextRunTimesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schedule, timezone string
+	var n int32
+	var from []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &schedule, &timezone, &n, &from))
+	if resp.Error != nil {
+		return
+	}
+
+	if intervalScheduleRegex.MatchString(schedule) {
+		resp.Error = function.NewArgumentFuncError(0, "interval schedules run relative to the last run, so don't have fixed run times")
+		return
+	}
+	cron, err := parseCron(schedule)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid cron expression: %s", err))
+		return
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("unknown timezone %q", timezone))
+		return
+	}
+	if n < 1 || n > maxNextRunTimes {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("n must be between 1 and %d", maxNextRunTimes))
+		return
+	}
+
+	t := time.Now()
+	if len(from) > 0 {
+		if t, err = time.Parse(time.RFC3339, from[0]); err != nil {
+			resp.Error = function.NewArgumentFuncError(3, fmt.Sprintf("invalid RFC3339 timestamp %q", from[0]))
+			return
+		}
+	}
+	t = t.In(loc)
+
+	out := []string{}
+	for len(out) < int(n) {
+		if t = cron.next(t); t.IsZero() {
+			break
+		}
+		out = append(out, t.Format(time.RFC3339))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, out))
+}
@@ -0,0 +1,313 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+var webhookType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"url":     types.StringType,
+		"headers": types.MapType{ElemType: types.StringType},
+		"payload": NormalizedJSONType{},
+	},
+}
+
+var snsTopicType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"topic_arn": types.StringType,
+		"region":    types.StringType,
+	},
+}
+
+var snsTopicListType = UnorderedListType{ListType: types.ListType{ElemType: snsTopicType}}
+
+var snsTopicArnRegex = regexp.MustCompile(`^arn:aws[a-z-]*:sns:([a-z0-9-]+):\d{12}:[A-Za-z0-9_-]+(\.fifo)?$`)
+
+var awsRegionRegex = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+
+var quietWindowType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"days":  types.ListType{ElemType: types.StringType},
+		"start": types.StringType,
+		"end":   types.StringType,
+	},
+}
+
+var quietHoursType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"timezone": types.StringType,
+		"windows":  types.ListType{ElemType: quietWindowType},
+		"channels": types.ListType{ElemType: types.StringType},
+	},
+}
+
+var weekdays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+var notificationChannels = []string{"emails", "slack", "pagerduty", "phones", "webhooks", "sns"}
+
+var e164Regex = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+var timeOfDayRegex = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`)
+
+var notificationEventsType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"failure":       types.BoolType,
+		"recovery":      types.BoolType,
+		"warning":       types.BoolType,
+		"weekly_report": types.BoolType,
+	},
+}
+
+// defaultNotificationEvents are the events cronitor sends to a list when it
+// hasn't been told otherwise.
+var defaultNotificationEvents = cronitor.NotificationEvents{
+	Failure:  true,
+	Recovery: true,
+	Warning:  true,
+}
+
+type WebhookModel struct {
+	Url     types.String        `tfsdk:"url"`
+	Headers types.Map           `tfsdk:"headers"`
+	Payload NormalizedJSONValue `tfsdk:"payload"`
+}
+
+type SnsTopicModel struct {
+	TopicArn types.String `tfsdk:"topic_arn"`
+	Region   types.String `tfsdk:"region"`
+}
+
+type QuietHoursModel struct {
+	Timezone types.String `tfsdk:"timezone"`
+	Windows  types.List   `tfsdk:"windows"`
+	Channels types.List   `tfsdk:"channels"`
+}
+
+type QuietWindowModel struct {
+	Days  types.List   `tfsdk:"days"`
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+type NotificationEventsModel struct {
+	Failure      types.Bool `tfsdk:"failure"`
+	Recovery     types.Bool `tfsdk:"recovery"`
+	Warning      types.Bool `tfsdk:"warning"`
+	WeeklyReport types.Bool `tfsdk:"weekly_report"`
+}
+
+func toNotificationEvents(in types.Object) *cronitor.NotificationEvents {
+	if in.IsNull() || in.IsUnknown() {
+		return nil
+	}
+	m := NotificationEventsModel{}
+	in.As(context.Background(), &m, basetypes.ObjectAsOptions{})
+	return &cronitor.NotificationEvents{
+		Failure:      m.Failure.ValueBool(),
+		Recovery:     m.Recovery.ValueBool(),
+		Warning:      m.Warning.ValueBool(),
+		WeeklyReport: m.WeeklyReport.ValueBool(),
+	}
+}
+
+func fromNotificationEvents(in *cronitor.NotificationEvents) types.Object {
+	if in == nil {
+		in = &defaultNotificationEvents
+	}
+	out, _ := types.ObjectValueFrom(context.Background(), notificationEventsType.AttrTypes, NotificationEventsModel{
+		Failure:      types.BoolValue(in.Failure),
+		Recovery:     types.BoolValue(in.Recovery),
+		Warning:      types.BoolValue(in.Warning),
+		WeeklyReport: types.BoolValue(in.WeeklyReport),
+	})
+	return out
+}
+
+func toWebhooks(in types.List) []cronitor.Webhook {
+	models := []WebhookModel{}
+	if in.IsNull() || in.IsUnknown() {
+		return nil
+	}
+	in.ElementsAs(context.Background(), &models, false)
+
+	var out []cronitor.Webhook
+	for _, m := range models {
+		w := cronitor.Webhook{
+			Url:     m.Url.ValueString(),
+			Payload: m.Payload.ValueString(),
+		}
+		if headers := toStringMap(m.Headers); len(headers) > 0 {
+			w.Headers = headers
+		}
+		out = append(out, w)
+	}
+	return out
+}
+
+func fromWebhooks(in []cronitor.Webhook) types.List {
+	models := []WebhookModel{}
+	for _, w := range in {
+		m := WebhookModel{
+			Url:     types.StringValue(w.Url),
+			Headers: types.MapNull(types.StringType),
+			Payload: NewNormalizedJSONValue(w.Payload),
+		}
+		if len(w.Headers) > 0 {
+			m.Headers, _ = types.MapValueFrom(context.Background(), types.StringType, w.Headers)
+		}
+		models = append(models, m)
+	}
+	out, _ := types.ListValueFrom(context.Background(), webhookType, models)
+	return out
+}
+
+// fixWebhookOrder sorts the webhooks into the same order as the urls in
+// correct, leaving any it doesn't know about at the end.
+func fixWebhookOrder(correct []cronitor.Webhook, incorrect []cronitor.Webhook) {
+	index := func(w cronitor.Webhook) int {
+		i := slices.IndexFunc(correct, func(c cronitor.Webhook) bool { return c.Url == w.Url })
+		if i == -1 {
+			return len(correct)
+		}
+		return i
+	}
+	slices.SortStableFunc(incorrect, func(a, b cronitor.Webhook) int {
+		return index(a) - index(b)
+	})
+}
+
+func toSnsTopics(in UnorderedListValue) []cronitor.SnsTopic {
+	models := []SnsTopicModel{}
+	if in.IsNull() || in.IsUnknown() {
+		return nil
+	}
+	in.ElementsAs(context.Background(), &models, false)
+
+	var out []cronitor.SnsTopic
+	for _, m := range models {
+		out = append(out, cronitor.SnsTopic{
+			TopicArn: m.TopicArn.ValueString(),
+			Region:   m.Region.ValueString(),
+		})
+	}
+	return out
+}
+
+func fromSnsTopics(in []cronitor.SnsTopic) UnorderedListValue {
+	models := []SnsTopicModel{}
+	for _, t := range in {
+		models = append(models, SnsTopicModel{
+			TopicArn: types.StringValue(t.TopicArn),
+			Region:   types.StringValue(t.Region),
+		})
+	}
+	out, _ := types.ListValueFrom(context.Background(), snsTopicType, models)
+	return UnorderedListValue{ListValue: out}
+}
+
+func toQuietHours(in types.Object) *cronitor.QuietHours {
+	if in.IsNull() || in.IsUnknown() {
+		return nil
+	}
+	m := QuietHoursModel{}
+	in.As(context.Background(), &m, basetypes.ObjectAsOptions{})
+	windows := []QuietWindowModel{}
+	m.Windows.ElementsAs(context.Background(), &windows, false)
+
+	out := &cronitor.QuietHours{
+		Timezone: m.Timezone.ValueString(),
+		Windows:  []cronitor.QuietWindow{},
+		Channels: toStringSlice(m.Channels),
+	}
+	for _, w := range windows {
+		out.Windows = append(out.Windows, cronitor.QuietWindow{
+			Days:  toStringSlice(w.Days),
+			Start: w.Start.ValueString(),
+			End:   w.End.ValueString(),
+		})
+	}
+	return out
+}
+
+func fromQuietHours(in *cronitor.QuietHours) types.Object {
+	if in == nil {
+		return types.ObjectNull(quietHoursType.AttrTypes)
+	}
+	windows := []QuietWindowModel{}
+	for _, w := range in.Windows {
+		windows = append(windows, QuietWindowModel{
+			Days:  stringSlice(w.Days),
+			Start: types.StringValue(w.Start),
+			End:   types.StringValue(w.End),
+		})
+	}
+	m := QuietHoursModel{
+		Timezone: types.StringValue(in.Timezone),
+		Channels: stringSlice(in.Channels),
+	}
+	m.Windows, _ = types.ListValueFrom(context.Background(), quietWindowType, windows)
+	out, _ := types.ObjectValueFrom(context.Background(), quietHoursType.AttrTypes, m)
+	return out
+}
+
+// validateChannels checks the values of each of the channels are valid, so
+// that mistakes are caught before the api rejects them.
+func validateChannels(data NotificationListModel, diags *diag.Diagnostics) {
+	eachString(data.Emails, func(i int, v string) {
+		if addr, err := mail.ParseAddress(v); err != nil || addr.Address != v {
+			diags.AddAttributeError(path.Root("emails").AtListIndex(i), "invalid email", fmt.Sprintf("%q is not a valid email address", v))
+		}
+	})
+	eachString(data.Phones, func(i int, v string) {
+		if !e164Regex.MatchString(v) {
+			diags.AddAttributeError(path.Root("phones").AtListIndex(i), "invalid phone number", fmt.Sprintf("%q must be an E.164 phone number, such as +447700900123", v))
+		}
+	})
+	eachString(data.Webhooks, func(i int, v string) {
+		if !validHttpUrl(v) {
+			diags.AddAttributeError(path.Root("webhooks").AtListIndex(i), "invalid webhook url", fmt.Sprintf("%q must be an http or https url", v))
+		}
+	})
+	if !data.Webhook.IsUnknown() {
+		webhooks := []WebhookModel{}
+		data.Webhook.ElementsAs(context.Background(), &webhooks, false)
+		for i, w := range webhooks {
+			if !w.Url.IsUnknown() && !validHttpUrl(w.Url.ValueString()) {
+				diags.AddAttributeError(path.Root("webhook").AtListIndex(i).AtName("url"), "invalid webhook url", fmt.Sprintf("%q must be an http or https url", w.Url.ValueString()))
+			}
+		}
+	}
+}
+
+// eachString calls f with each of the known values in the list.
+func eachString(in basetypes.ListValuable, f func(int, string)) {
+	list, _ := in.ToListValue(context.Background())
+	if list.IsNull() || list.IsUnknown() {
+		return
+	}
+	for i, e := range list.Elements() {
+		if v, ok := e.(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+			f(i, v.ValueString())
+		}
+	}
+}
+
+func validHttpUrl(in string) bool {
+	u, err := url.Parse(in)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
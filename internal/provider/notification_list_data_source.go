@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
@@ -16,6 +18,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &NotificationListDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &NotificationListDataSource{}
 
 func NewExampleDataSource() datasource.DataSource {
 	return &NotificationListDataSource{}
@@ -36,38 +39,155 @@ func (n *NotificationListDataSource) Schema(ctx context.Context, req datasource.
 
 		Attributes: map[string]schema.Attribute{
 			"key": schema.StringAttribute{
-				MarkdownDescription: "The notification list id",
-				Required:            true,
+				MarkdownDescription: "The notification list id, either this or `name` must be set",
+				Optional:            true,
+				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The notification list name",
+				MarkdownDescription: "The notification list name, either this or `key` must be set. Lists are found by name from one list of every notification list, shared by all the data sources in the run",
+				Optional:            true,
 				Computed:            true,
 			},
 			"emails": schema.ListAttribute{
 				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
 				MarkdownDescription: "The emails to send notifications to",
 				Computed:            true,
 			},
 			"slack": schema.ListAttribute{
 				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
 				MarkdownDescription: "The slack channels to send notifications to",
 				Computed:            true,
 			},
 			"pagerduty": schema.ListAttribute{
 				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
 				MarkdownDescription: "The slack channels to send notifications to",
 				Computed:            true,
 			},
 			"phones": schema.ListAttribute{
 				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
 				MarkdownDescription: "The phone numbers to send notifications to",
 				Computed:            true,
 			},
 			"webhooks": schema.ListAttribute{
 				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
 				MarkdownDescription: "The webhook urls to send notifications to",
 				Computed:            true,
 			},
+			"webhook": schema.ListNestedAttribute{
+				MarkdownDescription: "The webhooks sent with custom headers and payload",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The url the webhook is sent to",
+							Computed:            true,
+						},
+						"headers": schema.MapAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "The headers sent with the webhook",
+							Computed:            true,
+						},
+						"payload": schema.StringAttribute{
+							CustomType:          NormalizedJSONType{},
+							MarkdownDescription: "The template for the json body of the webhook",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"sns": schema.ListNestedAttribute{
+				CustomType:          snsTopicListType,
+				MarkdownDescription: "The aws sns topics notifications are published to",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"topic_arn": schema.StringAttribute{
+							MarkdownDescription: "The arn of the topic",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "The aws region the topic is in",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"attached_monitor_keys": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The keys of the monitors that send notifications to the list",
+				Computed:            true,
+			},
+			"attached_monitor_count": schema.Int32Attribute{
+				MarkdownDescription: "The number of monitors that send notifications to the list",
+				Computed:            true,
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is the account's default notification list",
+				Computed:            true,
+			},
+			"quiet_hours": schema.SingleNestedAttribute{
+				MarkdownDescription: "Times when the notification list's channels are silenced",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"timezone": schema.StringAttribute{
+						MarkdownDescription: "The timezone the windows are in",
+						Computed:            true,
+					},
+					"windows": schema.ListNestedAttribute{
+						MarkdownDescription: "The windows that notifications are silenced during",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"days": schema.ListAttribute{
+									ElementType:         types.StringType,
+									MarkdownDescription: "The days the window applies on",
+									Computed:            true,
+								},
+								"start": schema.StringAttribute{
+									MarkdownDescription: "The time the window starts",
+									Computed:            true,
+								},
+								"end": schema.StringAttribute{
+									MarkdownDescription: "The time the window ends",
+									Computed:            true,
+								},
+							},
+						},
+					},
+					"channels": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "The channels that are silenced, all channels when not set",
+						Computed:            true,
+					},
+				},
+			},
+			"events": schema.SingleNestedAttribute{
+				MarkdownDescription: "The events the notification list is alerted on",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"failure": schema.BoolAttribute{
+						MarkdownDescription: "Alert when a monitor fails",
+						Computed:            true,
+					},
+					"recovery": schema.BoolAttribute{
+						MarkdownDescription: "Alert when a monitor recovers",
+						Computed:            true,
+					},
+					"warning": schema.BoolAttribute{
+						MarkdownDescription: "Alert on warnings, such as a monitor running late",
+						Computed:            true,
+					},
+					"weekly_report": schema.BoolAttribute{
+						MarkdownDescription: "Send weekly reports",
+						Computed:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -93,6 +213,9 @@ func (n *NotificationListDataSource) Configure(ctx context.Context, req datasour
 }
 
 func (d *NotificationListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnThrottled(d.client, &resp.Diagnostics)
+	ctx = d.client.WithOperation(ctx)
+
 	var data NotificationListModel
 
 	// Read Terraform configuration data into the model
@@ -102,9 +225,19 @@ func (d *NotificationListDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	list, err := d.client.GetNotificationList(ctx, data.Key.ValueString())
+	key := data.Key.ValueString()
+	if data.Key.IsNull() {
+		found, err := d.client.FindNotificationListByName(ctx, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failed to find notification list", apiErrorDetail(ctx, err))
+			return
+		}
+		key = found.Key
+	}
+
+	list, err := d.client.GetNotificationListCached(ctx, key)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to get notification list", err.Error())
+		resp.Diagnostics.AddError("failed to get notification list", apiErrorDetail(ctx, err))
 		return
 	}
 
@@ -117,3 +250,12 @@ func (d *NotificationListDataSource) Read(ctx context.Context, req datasource.Re
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (d *NotificationListDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("key"),
+			path.MatchRoot("name"),
+		),
+	}
+}
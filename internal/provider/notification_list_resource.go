@@ -5,14 +5,22 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
@@ -21,6 +29,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NotificationListResource{}
 var _ resource.ResourceWithImportState = &NotificationListResource{}
+var _ resource.ResourceWithConfigValidators = &NotificationListResource{}
 
 func NewNotificationListResource() resource.Resource {
 	return &NotificationListResource{}
@@ -42,10 +51,15 @@ func (r *NotificationListResource) Schema(ctx context.Context, req resource.Sche
 
 		Attributes: map[string]schema.Attribute{
 			"key": schema.StringAttribute{
-				MarkdownDescription: "The notification list id",
+				MarkdownDescription: "The notification list id, generated from the name when not set. Changing this creates a new notification list",
+				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(listKeyRegex, "must only contain lower case letters, numbers, dashes and underscores"),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -54,6 +68,7 @@ func (r *NotificationListResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"emails": schema.ListAttribute{
 				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
 				MarkdownDescription: "The emails to send notifications to",
 				Optional:            true,
 				Computed:            true,
@@ -61,6 +76,7 @@ func (r *NotificationListResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"slack": schema.ListAttribute{
 				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
 				MarkdownDescription: "The slack channels to send notifications to",
 				Optional:            true,
 				Computed:            true,
@@ -68,6 +84,7 @@ func (r *NotificationListResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"pagerduty": schema.ListAttribute{
 				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
 				MarkdownDescription: "The slack channels to send notifications to",
 				Optional:            true,
 				Computed:            true,
@@ -75,6 +92,7 @@ func (r *NotificationListResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"phones": schema.ListAttribute{
 				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
 				MarkdownDescription: "The phone numbers to send notifications to",
 				Optional:            true,
 				Computed:            true,
@@ -82,11 +100,161 @@ func (r *NotificationListResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"webhooks": schema.ListAttribute{
 				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
 				MarkdownDescription: "The webhook urls to send notifications to",
 				Optional:            true,
 				Computed:            true,
 				Default:             listdefault.StaticValue(types.ListNull(types.StringType)),
 			},
+			"attached_monitor_keys": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The keys of the monitors that send notifications to the list",
+				Computed:            true,
+			},
+			"attached_monitor_count": schema.Int32Attribute{
+				MarkdownDescription: "The number of monitors that send notifications to the list",
+				Computed:            true,
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is the account's default notification list, used by monitors with `notify = [\"default\"]`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"quiet_hours": schema.SingleNestedAttribute{
+				MarkdownDescription: "Times when the notification list's channels are silenced",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"timezone": schema.StringAttribute{
+						MarkdownDescription: "The timezone the windows are in, such as `Europe/London`",
+						Required:            true,
+					},
+					"windows": schema.ListNestedAttribute{
+						MarkdownDescription: "The windows that notifications are silenced during",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"days": schema.ListAttribute{
+									ElementType:         types.StringType,
+									MarkdownDescription: "The days the window applies on, any of `" + strings.Join(weekdays, "`, `") + "`",
+									Required:            true,
+									Validators: []validator.List{
+										listvalidator.SizeAtLeast(1),
+										listvalidator.ValueStringsAre(stringvalidator.OneOf(weekdays...)),
+									},
+								},
+								"start": schema.StringAttribute{
+									MarkdownDescription: "The time the window starts, in the form `HH:MM`",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(timeOfDayRegex, "must be a time in the form HH:MM"),
+									},
+								},
+								"end": schema.StringAttribute{
+									MarkdownDescription: "The time the window ends, in the form `HH:MM`. Windows that end before they start run over midnight",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(timeOfDayRegex, "must be a time in the form HH:MM"),
+									},
+								},
+							},
+						},
+					},
+					"channels": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "The channels that are silenced, any of `" + strings.Join(notificationChannels, "`, `") + "`. All channels are silenced when not set",
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+						},
+					},
+				},
+			},
+			"events": schema.SingleNestedAttribute{
+				MarkdownDescription: "The events the notification list is alerted on, left unchanged when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"failure": schema.BoolAttribute{
+						MarkdownDescription: "Alert when a monitor fails",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"recovery": schema.BoolAttribute{
+						MarkdownDescription: "Alert when a monitor recovers",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"warning": schema.BoolAttribute{
+						MarkdownDescription: "Alert on warnings, such as a monitor running late",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"weekly_report": schema.BoolAttribute{
+						MarkdownDescription: "Send weekly reports",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"sns": schema.ListNestedBlock{
+				CustomType:          snsTopicListType,
+				MarkdownDescription: "AWS SNS topics to publish notifications to",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"topic_arn": schema.StringAttribute{
+							MarkdownDescription: "The arn of the topic",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(snsTopicArnRegex, "must be an sns topic arn"),
+							},
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "The aws region the topic is in",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(awsRegionRegex, "must be an aws region"),
+							},
+						},
+					},
+				},
+			},
+			"webhook": schema.ListNestedBlock{
+				MarkdownDescription: "Webhooks to send notifications to with custom headers and payload",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The url to send the webhook to",
+							Required:            true,
+						},
+						"headers": schema.MapAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "The headers to send with the webhook",
+							Optional:            true,
+						},
+						"payload": schema.StringAttribute{
+							CustomType:          NormalizedJSONType{},
+							MarkdownDescription: "A template for the json body of the webhook, the cronitor default payload is sent when not set",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -111,7 +279,9 @@ func (r *NotificationListResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *NotificationListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data NotificationListModel
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data NotificationListResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -120,13 +290,22 @@ func (r *NotificationListResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	list, err := r.client.CreateNotificationList(ctx, listToListRequest(data))
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	list, err := r.client.CreateNotificationList(ctx, listToListRequest(data.NotificationListModel))
 	if err != nil {
-		resp.Diagnostics.AddError("failed to create notification list", err.Error())
+		resp.Diagnostics.AddError("failed to create notification list", apiErrorDetail(ctx, err))
 		return
 	}
 
-	data = toNotificationList(list)
+	data.NotificationListModel = toNotificationList(list)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -137,7 +316,9 @@ func (r *NotificationListResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *NotificationListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data NotificationListModel
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data NotificationListResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -146,29 +327,44 @@ func (r *NotificationListResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	state := listToListRequest(data)
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	state := listToListRequest(data.NotificationListModel)
 
 	list, err := r.client.GetNotificationList(ctx, data.Key.ValueString())
+	if errors.Is(err, cronitor.ErrNotFound) {
+		tflog.Warn(ctx, "notification list no longer exists, removing from state", map[string]any{"key": data.Key.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if keepStateOffline(r.client, err, &resp.Diagnostics) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("failed to get notification list from api", err.Error())
+		resp.Diagnostics.AddError("failed to get notification list from api", apiErrorDetail(ctx, err))
 		return
 	}
 
-	fixSliceOrder(state.Notifications.Emails, &list.Notifications.Emails)
-	fixSliceOrder(state.Notifications.Slack, &list.Notifications.Slack)
-	fixSliceOrder(state.Notifications.Pagerduty, &list.Notifications.Pagerduty)
-	fixSliceOrder(state.Notifications.Phones, &list.Notifications.Phones)
-	fixSliceOrder(state.Notifications.Webhooks, &list.Notifications.Webhooks)
+	fixWebhookOrder(state.Notifications.CustomWebhooks, list.Notifications.CustomWebhooks)
 
-	data = toNotificationList(list)
+	data.NotificationListModel = toNotificationList(list)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *NotificationListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state NotificationListModel
-	var plan NotificationListModel
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var state NotificationListResourceModel
+	var plan NotificationListResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -178,27 +374,35 @@ func (r *NotificationListResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	upd := listToListRequest(plan)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	upd := listToListRequest(plan.NotificationListModel)
 	list, err := r.client.UpdateNotificationList(ctx, upd)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to update heartbeat monitor", err.Error())
+		resp.Diagnostics.AddError("failed to update heartbeat monitor", apiErrorDetail(ctx, err))
 		return
 	}
 
-	fixSliceOrder(upd.Notifications.Emails, &list.Notifications.Emails)
-	fixSliceOrder(upd.Notifications.Slack, &list.Notifications.Slack)
-	fixSliceOrder(upd.Notifications.Pagerduty, &list.Notifications.Pagerduty)
-	fixSliceOrder(upd.Notifications.Phones, &list.Notifications.Phones)
-	fixSliceOrder(upd.Notifications.Webhooks, &list.Notifications.Webhooks)
+	fixWebhookOrder(upd.Notifications.CustomWebhooks, list.Notifications.CustomWebhooks)
 
-	state = toNotificationList(list)
+	state.NotificationListModel = toNotificationList(list)
+	state.Timeouts = plan.Timeouts
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *NotificationListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data NotificationListModel
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data NotificationListResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -207,18 +411,52 @@ func (r *NotificationListResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	if err := r.client.DeleteNotificationList(ctx, listToListRequest(data)); err != nil {
-		resp.Diagnostics.AddError("failed to delete record", err.Error())
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	// Something else has already deleted it, which is what we wanted anyway
+	if err := r.client.DeleteNotificationList(ctx, listToListRequest(data.NotificationListModel)); err != nil && !errors.Is(err, cronitor.ErrNotFound) {
+		resp.Diagnostics.AddError("failed to delete record", apiErrorDetail(ctx, err))
 		return
 	}
 }
 
+// ImportState imports the notification list by its key, or by its name when
+// the id is in the form name=<name>.
 func (r *NotificationListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	name, ok := strings.CutPrefix(req.ID, "name=")
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+		return
+	}
+	ctx = r.client.WithOperation(ctx)
+
+	list, err := r.client.FindNotificationListByName(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to find notification list", apiErrorDetail(ctx, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), list.Key)...)
+}
+
+func (r *NotificationListResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		notificationChannelsValidator{},
+		deprecationValidator{deprecations: notificationListDeprecations},
+	}
 }
 
 func (r *NotificationListResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data NotificationListModel
+	var data NotificationListResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -226,6 +464,19 @@ func (r *NotificationListResource) ValidateConfig(ctx context.Context, req resou
 		return
 	}
 
+	validateChannels(data.NotificationListModel, &resp.Diagnostics)
+
+	for i, topic := range toSnsTopics(data.Sns) {
+		match := snsTopicArnRegex.FindStringSubmatch(topic.TopicArn)
+		if match != nil && topic.Region != "" && match[1] != topic.Region {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sns").AtListIndex(i).AtName("region"),
+				"mismatched sns region",
+				fmt.Sprintf("region %s doesn't match the region of the topic arn %s", topic.Region, topic.TopicArn),
+			)
+		}
+	}
+
 	// if err := data.validate(); err != nil {
 	// 	resp.Diagnostics.AddError("monitor failed validation", err.Error())
 	// 	return
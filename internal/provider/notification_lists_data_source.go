@@ -0,0 +1,197 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NotificationListsDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &NotificationListsDataSource{}
+
+var notificationListSummaryType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"key":  types.StringType,
+		"name": types.StringType,
+	},
+}
+
+type NotificationListsModel struct {
+	Email     types.String `tfsdk:"email"`
+	Slack     types.String `tfsdk:"slack"`
+	Pagerduty types.String `tfsdk:"pagerduty"`
+	Phone     types.String `tfsdk:"phone"`
+	Webhook   types.String `tfsdk:"webhook"`
+	Lists     types.List   `tfsdk:"lists"`
+}
+
+type NotificationListSummaryModel struct {
+	Key  types.String `tfsdk:"key"`
+	Name types.String `tfsdk:"name"`
+}
+
+func NewNotificationListsDataSource() datasource.DataSource {
+	return &NotificationListsDataSource{}
+}
+
+// NotificationListsDataSource finds the notification lists that send to a
+// channel.
+type NotificationListsDataSource struct {
+	client *cronitor.Client
+}
+
+func (d *NotificationListsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_lists"
+}
+
+func (d *NotificationListsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Finds the notification lists that send notifications to a channel. Exactly one channel must be set",
+
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Find the lists that send to this email, ignoring case",
+				Optional:            true,
+			},
+			"slack": schema.StringAttribute{
+				MarkdownDescription: "Find the lists that send to this slack channel",
+				Optional:            true,
+			},
+			"pagerduty": schema.StringAttribute{
+				MarkdownDescription: "Find the lists that send to this pagerduty integration",
+				Optional:            true,
+			},
+			"phone": schema.StringAttribute{
+				MarkdownDescription: "Find the lists that send to this phone number",
+				Optional:            true,
+			},
+			"webhook": schema.StringAttribute{
+				MarkdownDescription: "Find the lists that send to this webhook url, including webhooks with custom headers or payloads",
+				Optional:            true,
+			},
+			"lists": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching notification lists",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The notification list id",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The notification list name",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NotificationListsDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("email"),
+			path.MatchRoot("slack"),
+			path.MatchRoot("pagerduty"),
+			path.MatchRoot("phone"),
+			path.MatchRoot("webhook"),
+		),
+	}
+}
+
+func (d *NotificationListsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cronitor.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *cronitor.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NotificationListsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnThrottled(d.client, &resp.Diagnostics)
+	ctx = d.client.WithOperation(ctx)
+
+	var data NotificationListsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lists, err := d.client.ListNotificationListsCached(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list notification lists", apiErrorDetail(ctx, err))
+		return
+	}
+
+	matches := []NotificationListSummaryModel{}
+	for _, l := range lists {
+		if sendsTo(l.Notifications, data) {
+			matches = append(matches, NotificationListSummaryModel{
+				Key:  types.StringValue(l.Key),
+				Name: types.StringValue(l.Name),
+			})
+		}
+	}
+
+	out, diags := types.ListValueFrom(ctx, notificationListSummaryType, matches)
+	resp.Diagnostics.Append(diags...)
+	data.Lists = out
+
+	tflog.Trace(ctx, "found notification lists by channel", map[string]any{"count": len(matches)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sendsTo reports whether the notifications include the channel set in the
+// data source config.
+func sendsTo(n cronitor.Notifications, data NotificationListsModel) bool {
+	switch {
+	case !data.Email.IsNull():
+		return slices.ContainsFunc(n.Emails, func(e string) bool {
+			return strings.EqualFold(e, data.Email.ValueString())
+		})
+	case !data.Slack.IsNull():
+		return slices.Contains(n.Slack, data.Slack.ValueString())
+	case !data.Pagerduty.IsNull():
+		return slices.Contains(n.Pagerduty, data.Pagerduty.ValueString())
+	case !data.Phone.IsNull():
+		return slices.Contains(n.Phones, data.Phone.ValueString())
+	case !data.Webhook.IsNull():
+		return slices.Contains(n.Webhooks, data.Webhook.ValueString()) || slices.ContainsFunc(n.CustomWebhooks, func(w cronitor.Webhook) bool {
+			return w.Url == data.Webhook.ValueString()
+		})
+	}
+	return false
+}
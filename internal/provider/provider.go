@@ -5,19 +5,39 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
 )
 
+const (
+	defaultCacheTTL            = 5 * time.Minute
+	defaultMaxIdleConns        = 100
+	defaultMaxIdleConnsPerHost = 20
+	defaultIdleConnTimeout     = 90 * time.Second
+)
+
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
 var _ provider.Provider = &CronitorProvider{}
 var _ provider.ProviderWithFunctions = &CronitorProvider{}
+var _ provider.ProviderWithEphemeralResources = &CronitorProvider{}
 
 // ScaffoldingProvider defines the provider implementation.
 type CronitorProvider struct {
@@ -29,8 +49,21 @@ type CronitorProvider struct {
 
 // ScaffoldingProviderModel describes the provider data model.
 type CronitorProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	ApiKey   types.String `tfsdk:"api_key"`
+	Endpoint     types.String `tfsdk:"endpoint"`
+	ApiKey       types.String `tfsdk:"api_key"`
+	TelemetryKey types.String `tfsdk:"telemetry_key"`
+	BatchRefresh types.Bool   `tfsdk:"batch_refresh"`
+	CacheTTL     types.String `tfsdk:"data_source_cache_ttl"`
+
+	OfflineRefresh types.Bool `tfsdk:"offline_refresh"`
+
+	OperationRetryBudget types.String `tfsdk:"retry_budget_per_operation"`
+	RunRetryBudget       types.String `tfsdk:"retry_budget_per_run"`
+	RetryServerErrors    types.Map    `tfsdk:"retry_server_errors"`
+
+	MaxIdleConns        types.Int32  `tfsdk:"max_idle_conns"`
+	MaxIdleConnsPerHost types.Int32  `tfsdk:"max_idle_conns_per_host"`
+	IdleConnTimeout     types.String `tfsdk:"idle_conn_timeout"`
 }
 
 func (p *CronitorProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -40,6 +73,7 @@ func (p *CronitorProvider) Metadata(ctx context.Context, req provider.MetadataRe
 
 func (p *CronitorProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage cronitor monitors, notification lists and status pages. When cronitor rate limits requests, the next resource or data source to finish warns with how many requests have been throttled so far in the run, and how long they waited in total",
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
 				MarkdownDescription: "The api key used to connect to cronitor",
@@ -49,6 +83,57 @@ func (p *CronitorProvider) Schema(ctx context.Context, req provider.SchemaReques
 				MarkdownDescription: "The cronitor base API endpoint",
 				Optional:            true,
 			},
+			"telemetry_key": schema.StringAttribute{
+				MarkdownDescription: "The telemetry key used to build ping urls, when not set the urls don't include a key",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"data_source_cache_ttl": schema.StringAttribute{
+				MarkdownDescription: "How long data sources reuse what they have read, so a notification list used by many modules is only fetched once, as a duration such as `30s`. Defaults to `5m`, set it to `0s` to always fetch",
+				Optional:            true,
+			},
+			"max_idle_conns": schema.Int32Attribute{
+				MarkdownDescription: fmt.Sprintf("The most idle connections to the api kept open for reuse, defaults to `%d`", defaultMaxIdleConns),
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"max_idle_conns_per_host": schema.Int32Attribute{
+				MarkdownDescription: fmt.Sprintf("The most idle connections kept open to each api host, defaults to `%d`. Raise it along with `-parallelism` so big applies reuse connections instead of opening new ones", defaultMaxIdleConnsPerHost),
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"idle_conn_timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long idle connections are kept open for, as a duration such as `30s`. Defaults to `%s`", defaultIdleConnTimeout),
+				Optional:            true,
+			},
+			"retry_budget_per_operation": schema.StringAttribute{
+				MarkdownDescription: "The most time a single resource or data source spends waiting to retry requests before it fails, as a duration such as `2m`. Not limited by default",
+				Optional:            true,
+			},
+			"retry_budget_per_run": schema.StringAttribute{
+				MarkdownDescription: "The most time the whole run spends waiting to retry requests, after which every request that would be retried fails instead, as a duration such as `10m`. Not limited by default",
+				Optional:            true,
+			},
+			"retry_server_errors": schema.MapAttribute{
+				ElementType:         types.BoolType,
+				MarkdownDescription: "Whether requests are retried when cronitor responds with a server error, by http method such as `{ POST = true }`. Only `GET` and `HEAD` requests are retried by default, as retrying anything else could make the same change twice",
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete)),
+				},
+			},
+			"offline_refresh": schema.BoolAttribute{
+				MarkdownDescription: "Keep the existing state of resources, with a warning, when refreshing them fails because cronitor can't be reached, so an outage doesn't block changes to everything else in the workspace. Changes made in cronitor during the outage aren't detected until it is refreshed again",
+				Optional:            true,
+			},
+			"batch_refresh": schema.BoolAttribute{
+				MarkdownDescription: "Refresh monitors from a single list of every monitor in the account, fetched once per run, instead of requesting each one. This is faster when the configuration manages most of the account's monitors, and slower when it only manages a few of a large account",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -67,13 +152,109 @@ func (p *CronitorProvider) Configure(ctx context.Context, req provider.Configure
 		endpoint = data.Endpoint.String()
 	}
 
+	cacheTTL := durationAttribute(data.CacheTTL, "data_source_cache_ttl", defaultCacheTTL, &resp.Diagnostics)
+	idleConnTimeout := durationAttribute(data.IdleConnTimeout, "idle_conn_timeout", defaultIdleConnTimeout, &resp.Diagnostics)
+	operationRetryBudget := durationAttribute(data.OperationRetryBudget, "retry_budget_per_operation", 0, &resp.Diagnostics)
+	runRetryBudget := durationAttribute(data.RunRetryBudget, "retry_budget_per_run", 0, &resp.Diagnostics)
+	retryMethods := map[string]bool{}
+	if !data.RetryServerErrors.IsNull() && !data.RetryServerErrors.IsUnknown() {
+		resp.Diagnostics.Append(data.RetryServerErrors.ElementsAs(ctx, &retryMethods, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = int(int32Default(data.MaxIdleConns, defaultMaxIdleConns))
+	transport.MaxIdleConnsPerHost = int(int32Default(data.MaxIdleConnsPerHost, defaultMaxIdleConnsPerHost))
+	transport.IdleConnTimeout = idleConnTimeout
+
 	// Example client configuration for data sources and resources
 	client := cronitor.NewClient(cronitor.NewClientOpts{
-		ApiKey:   data.ApiKey.ValueString(),
-		Endpoint: endpoint,
+		ApiKey:       data.ApiKey.ValueString(),
+		TelemetryKey: data.TelemetryKey.ValueString(),
+		Endpoint:     endpoint,
+		Client:       &http.Client{Transport: transport},
+		BatchReads:   data.BatchRefresh.ValueBool(),
+		ReadCacheTTL: cacheTTL,
+
+		OperationRetryBudget: operationRetryBudget,
+		RunRetryBudget:       runRetryBudget,
+		RetryMethods:         retryMethods,
+
+		OfflineRefresh: data.OfflineRefresh.ValueBool(),
+		OnThrottle: func(ctx context.Context, interval time.Duration) {
+			tflog.Warn(ctx, "cronitor is rate limiting requests, slowing down for the rest of the run", map[string]any{"interval": interval.String()})
+		},
 	})
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+}
+
+// durationAttribute parses the duration in the provider config, returning def
+// when it isn't set.
+func durationAttribute(in types.String, name string, def time.Duration, diags *diag.Diagnostics) time.Duration {
+	if in.IsNull() || in.IsUnknown() {
+		return def
+	}
+	d, err := time.ParseDuration(in.ValueString())
+	if err != nil || d < 0 {
+		diags.AddAttributeError(path.Root(name), "invalid "+strings.ReplaceAll(name, "_", " "), fmt.Sprintf("%q is not a valid duration, such as 30s or 5m", in.ValueString()))
+		return def
+	}
+	return d
+}
+
+func int32Default(in types.Int32, def int32) int32 {
+	if in.IsNull() || in.IsUnknown() {
+		return def
+	}
+	return in.ValueInt32()
+}
+
+// warnThrottled adds a warning when the api has rate limited requests since the
+// last warning, so that slow runs explain themselves. The framework has no hook
+// at the end of a run, so every resource, data source and ephemeral resource
+// operation that calls the api defers it instead. The first of them to finish
+// after requests are throttled warns with the totals for the run so far.
+func warnThrottled(c *cronitor.Client, diags *diag.Diagnostics) {
+	if c == nil {
+		return
+	}
+	stats, ok := c.ThrottleReport()
+	if !ok {
+		return
+	}
+	diags.AddWarning(
+		"cronitor rate limited requests",
+		fmt.Sprintf("%d requests were throttled so far in this run, waiting %s in total", stats.Throttled, stats.Waited.Round(time.Second)),
+	)
+}
+
+// apiErrorDetail returns the detail of an error diagnostic for err, along with
+// the id of the last request made during the operation so it can be found in
+// cronitor's logs.
+func apiErrorDetail(ctx context.Context, err error) string {
+	ids := cronitor.RequestIDs(ctx)
+	if len(ids) == 0 {
+		return err.Error()
+	}
+	return fmt.Sprintf("%s\n\nrequest id: %s", err, ids[len(ids)-1])
+}
+
+// keepStateOffline reports whether a read that failed should keep the prior
+// state, which is when offline_refresh is on and cronitor couldn't be reached.
+// It warns that the resource wasn't refreshed.
+func keepStateOffline(c *cronitor.Client, err error, diags *diag.Diagnostics) bool {
+	if c == nil || !c.OfflineRefresh || !cronitor.IsUnreachable(err) {
+		return false
+	}
+	diags.AddWarning(
+		"cronitor is unreachable, keeping the existing state",
+		fmt.Sprintf("The resource wasn't refreshed as offline_refresh is enabled: %s", err),
+	)
+	return true
 }
 
 func (p *CronitorProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -81,17 +262,44 @@ func (p *CronitorProvider) Resources(ctx context.Context) []func() resource.Reso
 		NewHttpMonitorResource,
 		NewHeartbeatMonitorResource,
 		NewNotificationListResource,
+		NewGroupResource,
+		NewStatusPageResource,
+		NewStatusPageSubscriberResource,
+		NewStatusPageIncidentResource,
+		NewIssueResource,
+		NewAlertActionResource,
+		NewAccountSettingsResource,
+		NewTelemetryEventResource,
+		NewWebhookSigningSecretResource,
 	}
 }
 
 func (p *CronitorProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewExampleDataSource,
+		NewNotificationListsDataSource,
+		NewMonitorImportsDataSource,
+	}
+}
+
+func (p *CronitorProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewTelemetryUrlEphemeralResource,
+		NewTestAlertEphemeralResource,
 	}
 }
 
 func (p *CronitorProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewValidateScheduleFunction,
+		NewStatusCodeAssertionFunction,
+		NewResponseTimeAssertionFunction,
+		NewJsonAssertionFunction,
+		NewHeaderAssertionFunction,
+		NewNextRunTimesFunction,
+		NewMonitorKeyFunction,
+		NewParseCronitorYamlFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
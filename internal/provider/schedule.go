@@ -0,0 +1,136 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	scheduleTypeInterval = "interval"
+	scheduleTypeCron     = "cron"
+)
+
+var scheduleSpecType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":       types.StringType,
+		"seconds":    types.Int32Type,
+		"expression": types.StringType,
+	},
+}
+
+var intervalScheduleRegex = regexp.MustCompile(`^every (\d+) (second|minute|hour|day)s?$`)
+
+var intervalUnits = map[string]int{
+	"second": 1,
+	"minute": 60,
+	"hour":   60 * 60,
+	"day":    24 * 60 * 60,
+}
+
+type ScheduleSpecModel struct {
+	Type       types.String `tfsdk:"type"`
+	Seconds    types.Int32  `tfsdk:"seconds"`
+	Expression types.String `tfsdk:"expression"`
+}
+
+func scheduleSpecAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "A structured form of `schedule`, either `{ type = \"interval\", seconds = 300 }` or `{ type = \"cron\", expression = \"*/5 * * * *\" }`",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The schedule type, one of `interval`, `cron`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(scheduleTypeInterval, scheduleTypeCron),
+				},
+			},
+			"seconds": schema.Int32Attribute{
+				MarkdownDescription: "The number of seconds between runs of an interval schedule",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"expression": schema.StringAttribute{
+				MarkdownDescription: "The cron expression of a cron schedule",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func toScheduleSpec(in types.Object) (ScheduleSpecModel, bool) {
+	out := ScheduleSpecModel{}
+	if in.IsNull() || in.IsUnknown() {
+		return out, false
+	}
+	in.As(context.Background(), &out, basetypes.ObjectAsOptions{})
+	return out, true
+}
+
+// schedule converts the spec into the schedule string used by the api.
+func (s ScheduleSpecModel) schedule() string {
+	if s.Type.ValueString() == scheduleTypeInterval {
+		return fmt.Sprintf("every %d seconds", s.Seconds.ValueInt32())
+	}
+	return s.Expression.ValueString()
+}
+
+// fromSchedule converts an api schedule back into the spec, keeping the prior
+// spec when it still produces the same schedule.
+func fromSchedule(schedule string, prior ScheduleSpecModel) ScheduleSpecModel {
+	spec := prior
+	if prior.schedule() != schedule {
+		spec = ScheduleSpecModel{
+			Type:       types.StringValue(scheduleTypeCron),
+			Seconds:    types.Int32Null(),
+			Expression: types.StringValue(schedule),
+		}
+		if match := intervalScheduleRegex.FindStringSubmatch(schedule); match != nil {
+			n, _ := strconv.Atoi(match[1])
+			spec.Type = types.StringValue(scheduleTypeInterval)
+			spec.Seconds = types.Int32Value(int32(n * intervalUnits[match[2]]))
+			spec.Expression = types.StringNull()
+		}
+	}
+	return spec
+}
+
+func validateScheduleSpec(in types.Object, diags *diag.Diagnostics) {
+	spec, ok := toScheduleSpec(in)
+	if !ok || spec.Type.IsUnknown() {
+		return
+	}
+	switch spec.Type.ValueString() {
+	case scheduleTypeInterval:
+		if spec.Seconds.IsNull() {
+			diags.AddAttributeError(path.Root("schedule_spec").AtName("seconds"), "missing interval", "seconds must be set for interval schedules")
+		}
+		if !spec.Expression.IsNull() {
+			diags.AddAttributeError(path.Root("schedule_spec").AtName("expression"), "invalid schedule", "expression can only be set for cron schedules")
+		}
+	case scheduleTypeCron:
+		if spec.Expression.IsNull() {
+			diags.AddAttributeError(path.Root("schedule_spec").AtName("expression"), "missing expression", "expression must be set for cron schedules")
+		}
+		if !spec.Seconds.IsNull() {
+			diags.AddAttributeError(path.Root("schedule_spec").AtName("seconds"), "invalid schedule", "seconds can only be set for interval schedules")
+		}
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StatusPageIncidentResource{}
+var _ resource.ResourceWithImportState = &StatusPageIncidentResource{}
+
+var incidentStatuses = []string{"investigating", "identified", "monitoring", "resolved", "scheduled", "in_progress", "completed"}
+
+func NewStatusPageIncidentResource() resource.Resource {
+	return &StatusPageIncidentResource{}
+}
+
+// StatusPageIncidentResource defines the resource implementation.
+type StatusPageIncidentResource struct {
+	client *cronitor.Client
+}
+
+func (r *StatusPageIncidentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_incident"
+}
+
+func (r *StatusPageIncidentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Status page incident resource, used for both incidents and planned maintenance",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The incident id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_page": schema.StringAttribute{
+				MarkdownDescription: "The key of the status page the incident is published on",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The incident title",
+				Required:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The incident status, one of `" + strings.Join(incidentStatuses, "`, `") + "`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(incidentStatuses...),
+				},
+			},
+			"components": schema.ListAttribute{
+				ElementType:         types.StringType,
+				CustomType:          unorderedStringListType,
+				MarkdownDescription: "The keys of the monitors on the status page affected by the incident",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"update": schema.ListNestedBlock{
+				MarkdownDescription: "The messages posted to the incident, oldest first. Add a block to post a new update",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The incident status when the update was posted, one of `" + strings.Join(incidentStatuses, "`, `") + "`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(incidentStatuses...),
+							},
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The update message",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *StatusPageIncidentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cronitor.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cronitor.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StatusPageIncidentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data StatusPageIncidentModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	incident, err := r.client.CreateStatusPageIncident(ctx, data.StatusPage.ValueString(), incidentToIncidentRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create status page incident", apiErrorDetail(ctx, err))
+		return
+	}
+
+	data = toStatusPageIncident(incident, data)
+
+	tflog.Trace(ctx, "created a status page incident")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageIncidentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data StatusPageIncidentModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	incident, err := r.client.GetStatusPageIncident(ctx, data.StatusPage.ValueString(), data.ID.ValueString())
+	if errors.Is(err, cronitor.ErrNotFound) {
+		tflog.Warn(ctx, "status page incident no longer exists, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if keepStateOffline(r.client, err, &resp.Diagnostics) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get status page incident from api", apiErrorDetail(ctx, err))
+		return
+	}
+
+	data = toStatusPageIncident(incident, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageIncidentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var state StatusPageIncidentModel
+	var plan StatusPageIncidentModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	upd := incidentToIncidentRequest(plan)
+	upd.ID = state.ID.ValueString()
+	incident, err := r.client.UpdateStatusPageIncident(ctx, state.StatusPage.ValueString(), upd)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update status page incident", apiErrorDetail(ctx, err))
+		return
+	}
+
+	state = toStatusPageIncident(incident, plan)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *StatusPageIncidentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data StatusPageIncidentModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	// Something else has already deleted it, which is what we wanted anyway
+	if err := r.client.DeleteStatusPageIncident(ctx, data.StatusPage.ValueString(), data.ID.ValueString()); err != nil && !errors.Is(err, cronitor.ErrNotFound) {
+		resp.Diagnostics.AddError("failed to delete status page incident", apiErrorDetail(ctx, err))
+		return
+	}
+}
+
+func (r *StatusPageIncidentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	page, id, ok := strings.Cut(req.ID, "/")
+	if !ok || page == "" || id == "" {
+		resp.Diagnostics.AddError("invalid import id", fmt.Sprintf("expected an id in the form <status page>/<id>, got %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status_page"), page)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
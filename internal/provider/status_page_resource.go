@@ -0,0 +1,336 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+var hexColorRegex = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+var statusPageThemes = []string{"light", "dark", "auto"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StatusPageResource{}
+var _ resource.ResourceWithImportState = &StatusPageResource{}
+
+func NewStatusPageResource() resource.Resource {
+	return &StatusPageResource{}
+}
+
+// StatusPageResource defines the resource implementation.
+type StatusPageResource struct {
+	client *cronitor.Client
+}
+
+func (r *StatusPageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page"
+}
+
+func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Status page resource",
+
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The status page id, generated by cronitor when not set. Changing this creates a new status page",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(monitorKeyRegex, "must only contain letters, numbers, dashes and underscores"),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The status page name",
+				Required:            true,
+			},
+			"subdomain": schema.StringAttribute{
+				MarkdownDescription: "The subdomain the status page is hosted at, as `<subdomain>.cronitorstatus.com`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(listKeyRegex, "must only contain lower case letters, numbers, dashes and underscores"),
+				},
+			},
+			"custom_domain": schema.StringAttribute{
+				MarkdownDescription: "A custom domain to host the status page at, such as `status.example.com`",
+				Optional:            true,
+			},
+			"logo_url": schema.StringAttribute{
+				MarkdownDescription: "The url of the logo shown on the status page",
+				Optional:            true,
+			},
+			"favicon_url": schema.StringAttribute{
+				MarkdownDescription: "The url of the favicon of the status page",
+				Optional:            true,
+			},
+			"brand_color": schema.StringAttribute{
+				MarkdownDescription: "The brand color of the status page, as a hex color such as `#1a73e8`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(hexColorRegex, "must be a hex color, such as #1a73e8"),
+				},
+			},
+			"theme": schema.StringAttribute{
+				MarkdownDescription: "The theme of the status page, one of `" + strings.Join(statusPageThemes, "`, `") + "`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(statusPageThemes...),
+				},
+			},
+			"custom_domain_target": schema.StringAttribute{
+				MarkdownDescription: "The hostname that a CNAME record for the custom domain needs to point at",
+				Computed:            true,
+			},
+			"tls_status": schema.StringAttribute{
+				MarkdownDescription: "The status of the TLS certificate for the custom domain, such as `pending` or `issued`",
+				Computed:            true,
+			},
+			"tls_ready": schema.BoolAttribute{
+				MarkdownDescription: "Whether the TLS certificate for the custom domain has been issued",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The url of the status page, using the custom domain when it is set",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"section": schema.ListNestedBlock{
+				MarkdownDescription: "A named section of monitors, shown in the order the sections and monitors are listed in. Sections are managed exclusively, so any added in the UI are removed",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The section name",
+							Required:            true,
+						},
+						"monitors": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "The keys of the monitors in the section",
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.UniqueValues(),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *StatusPageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cronitor.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cronitor.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data StatusPageModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	page, err := r.client.CreateStatusPage(ctx, statusPageToStatusPageRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create status page", apiErrorDetail(ctx, err))
+		return
+	}
+
+	data = toStatusPage(page, data)
+
+	tflog.Trace(ctx, "created a status page")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data StatusPageModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	page, err := r.client.GetStatusPage(ctx, data.Key.ValueString())
+	if errors.Is(err, cronitor.ErrNotFound) {
+		tflog.Warn(ctx, "status page no longer exists, removing from state", map[string]any{"key": data.Key.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if keepStateOffline(r.client, err, &resp.Diagnostics) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get status page from api", apiErrorDetail(ctx, err))
+		return
+	}
+
+	data = toStatusPage(page, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var state StatusPageModel
+	var plan StatusPageModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	upd := statusPageToStatusPageRequest(plan)
+	upd.Key = state.Key.ValueString()
+	page, err := r.client.UpdateStatusPage(ctx, upd)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update status page", apiErrorDetail(ctx, err))
+		return
+	}
+
+	state = toStatusPage(page, plan)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *StatusPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data StatusPageModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	// Something else has already deleted it, which is what we wanted anyway
+	if err := r.client.DeleteStatusPage(ctx, data.Key.ValueString()); err != nil && !errors.Is(err, cronitor.ErrNotFound) {
+		resp.Diagnostics.AddError("failed to delete status page", apiErrorDetail(ctx, err))
+		return
+	}
+}
+
+func (r *StatusPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+}
+
+func (r *StatusPageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data StatusPageModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, attr := range []struct {
+		name string
+		val  types.String
+	}{{"logo_url", data.LogoUrl}, {"favicon_url", data.FaviconUrl}} {
+		if !attr.val.IsNull() && !attr.val.IsUnknown() && !validHttpUrl(attr.val.ValueString()) {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.name), "invalid url", fmt.Sprintf("%s must be an http or https url", attr.name))
+		}
+	}
+	if !data.CustomDomain.IsNull() && !data.CustomDomain.IsUnknown() {
+		if u, err := url.Parse("https://" + data.CustomDomain.ValueString()); err != nil || u.Host != data.CustomDomain.ValueString() || !strings.Contains(u.Host, ".") {
+			resp.Diagnostics.AddAttributeError(path.Root("custom_domain"), "invalid custom_domain", "custom_domain must be a hostname, such as status.example.com")
+		}
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StatusPageSubscriberResource{}
+var _ resource.ResourceWithImportState = &StatusPageSubscriberResource{}
+
+func NewStatusPageSubscriberResource() resource.Resource {
+	return &StatusPageSubscriberResource{}
+}
+
+// StatusPageSubscriberResource defines the resource implementation.
+type StatusPageSubscriberResource struct {
+	client *cronitor.Client
+}
+
+func (r *StatusPageSubscriberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_subscriber"
+}
+
+func (r *StatusPageSubscriberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Status page subscriber resource. Subscribers can't be changed, so any change creates a new subscriber",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The subscriber id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_page": schema.StringAttribute{
+				MarkdownDescription: "The key of the status page",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of subscriber, one of `" + subscriberTypeEmail + "`, `" + subscriberTypeWebhook + "`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(subscriberTypeEmail, subscriberTypeWebhook),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of an `email` subscriber",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The url of a `webhook` subscriber",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *StatusPageSubscriberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cronitor.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cronitor.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StatusPageSubscriberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data StatusPageSubscriberModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	sub, err := r.client.CreateStatusPageSubscriber(ctx, data.StatusPage.ValueString(), subscriberToSubscriberRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create status page subscriber", apiErrorDetail(ctx, err))
+		return
+	}
+
+	data = toStatusPageSubscriber(sub, data)
+
+	tflog.Trace(ctx, "created a status page subscriber")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageSubscriberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data StatusPageSubscriberModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	sub, err := r.client.GetStatusPageSubscriber(ctx, data.StatusPage.ValueString(), data.ID.ValueString())
+	if errors.Is(err, cronitor.ErrNotFound) {
+		tflog.Warn(ctx, "status page subscriber no longer exists, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if keepStateOffline(r.client, err, &resp.Diagnostics) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get status page subscriber from api", apiErrorDetail(ctx, err))
+		return
+	}
+
+	data = toStatusPageSubscriber(sub, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only saves the plan, as the timeouts are the only thing that can change
+// without replacing the status page subscriber.
+func (r *StatusPageSubscriberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StatusPageSubscriberModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageSubscriberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data StatusPageSubscriberModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	// Something else has already deleted it, which is what we wanted anyway
+	if err := r.client.DeleteStatusPageSubscriber(ctx, data.StatusPage.ValueString(), data.ID.ValueString()); err != nil && !errors.Is(err, cronitor.ErrNotFound) {
+		resp.Diagnostics.AddError("failed to delete status page subscriber", apiErrorDetail(ctx, err))
+		return
+	}
+}
+
+// ImportState imports the subscriber from an id in the form <status page>/<id>.
+func (r *StatusPageSubscriberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	page, id, ok := strings.Cut(req.ID, "/")
+	if !ok || page == "" || id == "" {
+		resp.Diagnostics.AddError("invalid import id", fmt.Sprintf("expected an id in the form <status page>/<id>, got %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status_page"), page)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *StatusPageSubscriberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data StatusPageSubscriberModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch data.Type.ValueString() {
+	case subscriberTypeEmail:
+		if data.Email.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("email"), "missing email", "email must be set for email subscribers")
+		} else if v := data.Email.ValueString(); !data.Email.IsUnknown() {
+			if addr, err := mail.ParseAddress(v); err != nil || addr.Address != v {
+				resp.Diagnostics.AddAttributeError(path.Root("email"), "invalid email", fmt.Sprintf("%q is not a valid email address", v))
+			}
+		}
+		if !data.Url.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("url"), "invalid subscriber", "url can only be set for webhook subscribers")
+		}
+	case subscriberTypeWebhook:
+		if data.Url.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("url"), "missing url", "url must be set for webhook subscribers")
+		} else if !data.Url.IsUnknown() && !validHttpUrl(data.Url.ValueString()) {
+			resp.Diagnostics.AddAttributeError(path.Root("url"), "invalid url", "url must be an http or https url")
+		}
+		if !data.Email.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("email"), "invalid subscriber", "email can only be set for email subscribers")
+		}
+	}
+}
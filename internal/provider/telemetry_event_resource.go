@@ -0,0 +1,275 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+var telemetryStates = []string{"run", "complete", "fail", "ok"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TelemetryEventResource{}
+
+type TelemetryEventModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Monitor  types.String   `tfsdk:"monitor"`
+	State    types.String   `tfsdk:"state"`
+	Env      types.String   `tfsdk:"env"`
+	Message  types.String   `tfsdk:"message"`
+	Series   types.String   `tfsdk:"series"`
+	Metric   types.Object   `tfsdk:"metric"`
+	Triggers types.Map      `tfsdk:"triggers"`
+	SentAt   types.String   `tfsdk:"sent_at"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type TelemetryMetricModel struct {
+	Count      types.Int64   `tfsdk:"count"`
+	Duration   types.Float64 `tfsdk:"duration"`
+	ErrorCount types.Int64   `tfsdk:"error_count"`
+}
+
+func NewTelemetryEventResource() resource.Resource {
+	return &TelemetryEventResource{}
+}
+
+// TelemetryEventResource defines the resource implementation.
+type TelemetryEventResource struct {
+	client *cronitor.Client
+}
+
+func (r *TelemetryEventResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_telemetry_event"
+}
+
+func (r *TelemetryEventResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sends a telemetry ping for a monitor when it is created, such as to record a deployment. Change `triggers` to send it again. Destroying the resource does nothing",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The key of the monitor the ping was sent for",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitor": schema.StringAttribute{
+				MarkdownDescription: "The key of the monitor to ping",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the event, one of `" + strings.Join(telemetryStates, "`, `") + "`",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(telemetryStates...),
+				},
+			},
+			"env": schema.StringAttribute{
+				MarkdownDescription: "The environment the event is recorded against",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "A message recorded with the event",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"series": schema.StringAttribute{
+				MarkdownDescription: "The series the event belongs to, used to match up the events of the same run",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metric": schema.SingleNestedAttribute{
+				MarkdownDescription: "Metrics recorded with the event",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Object{
+					objectvalidator.AtLeastOneOf(
+						path.MatchRelative().AtName("count"),
+						path.MatchRelative().AtName("duration"),
+						path.MatchRelative().AtName("error_count"),
+					),
+				},
+				Attributes: map[string]schema.Attribute{
+					"count": schema.Int64Attribute{
+						MarkdownDescription: "A count, such as the number of records processed",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"duration": schema.Float64Attribute{
+						MarkdownDescription: "The duration of the run in seconds",
+						Optional:            true,
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0),
+						},
+					},
+					"error_count": schema.Int64Attribute{
+						MarkdownDescription: "The number of errors",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that send the ping again when they change",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"sent_at": schema.StringAttribute{
+				MarkdownDescription: "When the ping was sent, in RFC3339 format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *TelemetryEventResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cronitor.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cronitor.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TelemetryEventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnThrottled(r.client, &resp.Diagnostics)
+
+	var data TelemetryEventModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = r.client.WithOperation(ctx)
+
+	opts := cronitor.PingOptions{
+		State:   data.State.ValueString(),
+		Env:     data.Env.ValueString(),
+		Message: data.Message.ValueString(),
+		Series:  data.Series.ValueString(),
+	}
+	if !data.Metric.IsNull() {
+		metric := TelemetryMetricModel{}
+		resp.Diagnostics.Append(data.Metric.As(ctx, &metric, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		opts.Metrics = cronitor.PingMetrics{
+			Count:      metric.Count.ValueInt64Pointer(),
+			Duration:   metric.Duration.ValueFloat64Pointer(),
+			ErrorCount: metric.ErrorCount.ValueInt64Pointer(),
+		}
+	}
+
+	if err := r.client.Ping(ctx, data.Monitor.ValueString(), opts); err != nil {
+		resp.Diagnostics.AddError("failed to send telemetry event", apiErrorDetail(ctx, err))
+		return
+	}
+
+	data.ID = data.Monitor
+	data.SentAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	tflog.Trace(ctx, "sent a telemetry event")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the prior state, as the ping has already been sent and there is
+// nothing to refresh.
+func (r *TelemetryEventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update only saves the plan, as the timeouts are the only thing that can change
+// without replacing the telemetry event.
+func (r *TelemetryEventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TelemetryEventModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the event from state, as a ping can't be unsent.
+func (r *TelemetryEventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,129 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &TelemetryUrlEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &TelemetryUrlEphemeralResource{}
+
+type TelemetryUrlModel struct {
+	Monitor types.String `tfsdk:"monitor"`
+	Env     types.String `tfsdk:"env"`
+	State   types.String `tfsdk:"state"`
+	Url     types.String `tfsdk:"url"`
+}
+
+func NewTelemetryUrlEphemeralResource() ephemeral.EphemeralResource {
+	return &TelemetryUrlEphemeralResource{}
+}
+
+// TelemetryUrlEphemeralResource builds the ping url of a monitor without
+// storing it in state.
+type TelemetryUrlEphemeralResource struct {
+	client *cronitor.Client
+}
+
+func (e *TelemetryUrlEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_telemetry_url"
+}
+
+func (e *TelemetryUrlEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The url to send pings for a monitor to, which is never stored in state. Requires terraform 1.10 or later",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor": schema.StringAttribute{
+				MarkdownDescription: "The key of the monitor",
+				Required:            true,
+			},
+			"env": schema.StringAttribute{
+				MarkdownDescription: "The environment the pings are recorded against",
+				Optional:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state sent with the pings, one of `" + strings.Join(telemetryStates, "`, `") + "`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(telemetryStates...),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The url to send pings to",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *TelemetryUrlEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cronitor.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *cronitor.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
+
+func (e *TelemetryUrlEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	defer warnThrottled(e.client, &resp.Diagnostics)
+
+	ctx = e.client.WithOperation(ctx)
+
+	var data TelemetryUrlModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Make sure the monitor exists, so a typo doesn't silently send pings nowhere
+	mon, err := e.client.GetMonitor(ctx, data.Monitor.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get monitor", apiErrorDetail(ctx, err))
+		return
+	}
+
+	query := url.Values{}
+	if v := data.Env.ValueString(); v != "" {
+		query.Set("env", v)
+	}
+	if v := data.State.ValueString(); v != "" {
+		query.Set("state", v)
+	}
+	u := e.client.TelemetryUrl(*mon.Key)
+	if len(query) > 0 {
+		u = fmt.Sprintf("%s?%s", u, query.Encode())
+	}
+	data.Url = types.StringValue(u)
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &TestAlertEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &TestAlertEphemeralResource{}
+
+type TestAlertModel struct {
+	NotificationList types.String `tfsdk:"notification_list"`
+	Monitor          types.String `tfsdk:"monitor"`
+	SentAt           types.String `tfsdk:"sent_at"`
+}
+
+func NewTestAlertEphemeralResource() ephemeral.EphemeralResource {
+	return &TestAlertEphemeralResource{}
+}
+
+// TestAlertEphemeralResource sends a test alert through a notification list
+// each time it is opened.
+type TestAlertEphemeralResource struct {
+	client *cronitor.Client
+}
+
+func (e *TestAlertEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_alert"
+}
+
+func (e *TestAlertEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sends a test alert to every channel of a notification list, to check the alerts arrive. An alert is sent every time terraform opens the resource, which includes plans as well as applies. Requires terraform 1.10 or later",
+
+		Attributes: map[string]schema.Attribute{
+			"notification_list": schema.StringAttribute{
+				MarkdownDescription: "The key of the notification list to send the alert through",
+				Required:            true,
+			},
+			"monitor": schema.StringAttribute{
+				MarkdownDescription: "The key of the monitor the alert is about, a generic test alert is sent when not set",
+				Optional:            true,
+			},
+			"sent_at": schema.StringAttribute{
+				MarkdownDescription: "When the alert was sent, in RFC3339 format",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *TestAlertEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cronitor.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *cronitor.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
+
+func (e *TestAlertEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	defer warnThrottled(e.client, &resp.Diagnostics)
+
+	ctx = e.client.WithOperation(ctx)
+
+	var data TestAlertModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The keys aren't known until the list and monitor are created, which is
+	// only during an apply
+	if data.NotificationList.IsUnknown() || data.Monitor.IsUnknown() {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	if err := e.client.SendTestAlert(ctx, data.NotificationList.ValueString(), data.Monitor.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to send test alert", apiErrorDetail(ctx, err))
+		return
+	}
+	data.SentAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	tflog.Info(ctx, "sent test alert", map[string]any{"notification_list": data.NotificationList.ValueString()})
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -4,59 +4,178 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"regexp"
 	"slices"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
 )
 
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+
+	ipVersionAny = "any"
+
+	defaultTimeout = 5 * time.Minute
+)
+
+var monitorKeyRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+var listKeyRegex = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+var ipVersions = []string{ipVersionAny, "ipv4", "ipv6"}
+
 type BaseMonitorModel struct {
-	Key               types.String `tfsdk:"key"`
-	Name              types.String `tfsdk:"name"`
-	Disabled          types.Bool   `tfsdk:"disabled"`
-	Paused            types.Bool   `tfsdk:"paused"`
-	Schedule          types.String `tfsdk:"schedule"`
-	Notify            types.List   `tfsdk:"notify"`
-	ScheduleTolerance types.Int32  `tfsdk:"schedule_tolerance"`
-	FailureTolerance  types.Int32  `tfsdk:"failure_tolerance"`
-	GraceSeconds      types.Int32  `tfsdk:"grace_seconds"`
-	RealertInterval   types.String `tfsdk:"realert_interval"`
-	Timezone          types.String `tfsdk:"timezone"`
-	Tags              types.List   `tfsdk:"tags"`
-	Environments      types.List   `tfsdk:"environments"`
-	Group             types.String `tfsdk:"group"`
+	Key                  types.String       `tfsdk:"key"`
+	Name                 types.String       `tfsdk:"name"`
+	Disabled             types.Bool         `tfsdk:"disabled"`
+	Paused               types.Bool         `tfsdk:"paused"`
+	Schedule             types.String       `tfsdk:"schedule"`
+	ScheduleSpec         types.Object       `tfsdk:"schedule_spec"`
+	Notify               UnorderedListValue `tfsdk:"notify"`
+	ScheduleTolerance    types.Int32        `tfsdk:"schedule_tolerance"`
+	FailureTolerance     types.Int32        `tfsdk:"failure_tolerance"`
+	GraceSeconds         types.Int32        `tfsdk:"grace_seconds"`
+	RealertInterval      types.String       `tfsdk:"realert_interval"`
+	Timezone             types.String       `tfsdk:"timezone"`
+	Tags                 TagListValue       `tfsdk:"tags"`
+	Environments         UnorderedListValue `tfsdk:"environments"`
+	Group                types.String       `tfsdk:"group"`
+	Position             types.Int32        `tfsdk:"position"`
+	Note                 types.String       `tfsdk:"note"`
+	RunbookUrl           types.String       `tfsdk:"runbook_url"`
+	Passing              types.Bool         `tfsdk:"passing"`
+	Running              types.Bool         `tfsdk:"running"`
+	Initialized          types.Bool         `tfsdk:"initialized"`
+	DashboardUrl         types.String       `tfsdk:"dashboard_url"`
+	SnoozeUntil          types.String       `tfsdk:"snooze_until"`
+	PauseOnDestroy       types.Bool         `tfsdk:"pause_on_destroy"`
+	DeletionProtection   types.Bool         `tfsdk:"deletion_protection"`
+	StatusPageProtection types.Bool         `tfsdk:"status_page_protection"`
+	AdoptExisting        types.Bool         `tfsdk:"adopt_existing"`
+	Timeouts             timeouts.Value     `tfsdk:"timeouts"`
+
+	EnvironmentOverrides types.List `tfsdk:"environment_overrides"`
+	Escalations          types.List `tfsdk:"escalation"`
+
+	EffectiveNotify          types.List   `tfsdk:"effective_notify"`
+	EffectiveTags            types.List   `tfsdk:"effective_tags"`
+	EffectiveRealertInterval types.String `tfsdk:"effective_realert_interval"`
 }
 
 type HttpMonitorModel struct {
 	BaseMonitorModel
 
-	Url             types.String `tfsdk:"url"`
-	Headers         types.Map    `tfsdk:"headers"`
-	Cookies         types.Map    `tfsdk:"cookies"`
-	Body            types.String `tfsdk:"body"`
-	Method          types.String `tfsdk:"method"`
-	TimeoutSeconds  types.Int32  `tfsdk:"timeout_seconds"`
-	Regions         types.List   `tfsdk:"regions"`
-	FollowRedirects types.Bool   `tfsdk:"follow_redirects"`
-	VerifySsl       types.Bool   `tfsdk:"verify_ssl"`
-	Assertions      types.List   `tfsdk:"assertions"`
+	Request             types.Object       `tfsdk:"request"`
+	HeadersMulti        types.Map          `tfsdk:"headers_multi"`
+	Assertions          UnorderedListValue `tfsdk:"assertions"`
+	BearerToken         types.String       `tfsdk:"bearer_token"`
+	ExpectedStatusCode  types.Int32        `tfsdk:"expected_status_code"`
+	ExpectedStatusCodes types.List         `tfsdk:"expected_status_codes"`
+	MaxResponseTimeMs   types.Int32        `tfsdk:"max_response_time_ms"`
+	SslExpiresWithin    types.Int32        `tfsdk:"ssl_expires_within_days"`
+	JsonAssertions      types.List         `tfsdk:"json_assertion"`
+	HeaderAssertions    types.List         `tfsdk:"header_assertion"`
+	Graphql             types.Object       `tfsdk:"graphql"`
+}
+
+var httpRequestType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"url":              types.StringType,
+		"method":           types.StringType,
+		"headers":          types.MapType{ElemType: types.StringType},
+		"cookies":          types.MapType{ElemType: types.StringType},
+		"body":             RequestBodyType{},
+		"timeout_seconds":  types.Int32Type,
+		"regions":          unorderedStringListType,
+		"follow_redirects": types.BoolType,
+		"max_redirects":    types.Int32Type,
+		"verify_ssl":       types.BoolType,
+		"client_cert_pem":  types.StringType,
+		"client_key_pem":   types.StringType,
+		"ip_version":       types.StringType,
+	},
+}
+
+// HttpRequestModel is the request sent by an http monitor, matching the request
+// object in the api.
+type HttpRequestModel struct {
+	Url             types.String       `tfsdk:"url"`
+	Method          types.String       `tfsdk:"method"`
+	Headers         types.Map          `tfsdk:"headers"`
+	Cookies         types.Map          `tfsdk:"cookies"`
+	Body            RequestBodyValue   `tfsdk:"body"`
+	TimeoutSeconds  types.Int32        `tfsdk:"timeout_seconds"`
+	Regions         UnorderedListValue `tfsdk:"regions"`
+	FollowRedirects types.Bool         `tfsdk:"follow_redirects"`
+	MaxRedirects    types.Int32        `tfsdk:"max_redirects"`
+	VerifySsl       types.Bool         `tfsdk:"verify_ssl"`
+	ClientCertPem   types.String       `tfsdk:"client_cert_pem"`
+	ClientKeyPem    types.String       `tfsdk:"client_key_pem"`
+	IPVersion       types.String       `tfsdk:"ip_version"`
+}
+
+type GraphqlModel struct {
+	Query     types.String `tfsdk:"query"`
+	Variables types.String `tfsdk:"variables"`
+}
+
+type JsonAssertionModel struct {
+	Path     types.String `tfsdk:"path"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+type HeaderAssertionModel struct {
+	Name     types.String `tfsdk:"name"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
 }
 
 type HeartbeatMonitorModel struct {
 	BaseMonitorModel
 
-	TelemetryUrl types.String `tfsdk:"telemetry_url"`
+	TelemetryUrl  types.String `tfsdk:"telemetry_url"`
+	TelemetryUrls types.Map    `tfsdk:"telemetry_urls"`
+	RunUrl        types.String `tfsdk:"run_url"`
+	CompleteUrl   types.String `tfsdk:"complete_url"`
+	FailUrl       types.String `tfsdk:"fail_url"`
+
+	MaxDurationSeconds types.Int32  `tfsdk:"max_duration_seconds"`
+	ScheduleType       types.String `tfsdk:"schedule_type"`
+	EverySeconds       types.Int32  `tfsdk:"every_seconds"`
 }
 
 type NotificationListModel struct {
-	Name      types.String `tfsdk:"name"`
-	Key       types.String `tfsdk:"key"`
-	Emails    types.List   `tfsdk:"emails"`
-	Slack     types.List   `tfsdk:"slack"`
-	Pagerduty types.List   `tfsdk:"pagerduty"`
-	Phones    types.List   `tfsdk:"phones"`
-	Webhooks  types.List   `tfsdk:"webhooks"`
+	Name      types.String       `tfsdk:"name"`
+	Key       types.String       `tfsdk:"key"`
+	Emails    UnorderedListValue `tfsdk:"emails"`
+	Slack     UnorderedListValue `tfsdk:"slack"`
+	Pagerduty UnorderedListValue `tfsdk:"pagerduty"`
+	Phones    UnorderedListValue `tfsdk:"phones"`
+	Webhooks  UnorderedListValue `tfsdk:"webhooks"`
+	Webhook   types.List         `tfsdk:"webhook"`
+	Sns       UnorderedListValue `tfsdk:"sns"`
+	Events    types.Object       `tfsdk:"events"`
+
+	QuietHours types.Object `tfsdk:"quiet_hours"`
+	IsDefault  types.Bool   `tfsdk:"is_default"`
+
+	AttachedMonitorKeys  types.List  `tfsdk:"attached_monitor_keys"`
+	AttachedMonitorCount types.Int32 `tfsdk:"attached_monitor_count"`
+}
+
+type NotificationListResourceModel struct {
+	NotificationListModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func processSlice[T, U any](in []T, t attr.Type, c func(T) U) types.List {
@@ -76,9 +195,10 @@ func stringSlice(in []string) types.List {
 	return processSlice(in, types.StringType, types.StringValue)
 }
 
-func toStringSlice(in types.List) []string {
+func toStringSlice(in basetypes.ListValuable) []string {
+	list, _ := in.ToListValue(context.Background())
 	temp := []types.String{}
-	in.ElementsAs(context.Background(), &temp, false)
+	list.ElementsAs(context.Background(), &temp, false)
 	out := []string{}
 	for _, e := range temp {
 		out = append(out, e.ValueString())
@@ -86,6 +206,157 @@ func toStringSlice(in types.List) []string {
 	return out
 }
 
+func toInt32Slice(in types.List) []int32 {
+	temp := []types.Int32{}
+	in.ElementsAs(context.Background(), &temp, false)
+	out := []int32{}
+	for _, e := range temp {
+		out = append(out, e.ValueInt32())
+	}
+	return out
+}
+
+func toStringListMap(in types.Map) map[string][]string {
+	temp := map[string]types.List{}
+	in.ElementsAs(context.Background(), &temp, false)
+	out := map[string][]string{}
+	for key, val := range temp {
+		out[key] = toStringSlice(val)
+	}
+	return out
+}
+
+// setInherited sets the settings that can be inherited from the monitor's
+// group. They are only set when they were set before, so values inherited from
+// the group are only shown in the effective attributes. After an import there
+// is nothing to go on, so they are all set to keep generated config from
+// resetting them.
+func (b *BaseMonitorModel) setInherited(m *cronitor.Monitor, prior BaseMonitorModel) {
+	b.EffectiveNotify = stringSlice(m.Notify)
+	b.EffectiveTags = stringSlice(m.Tags)
+	b.EffectiveRealertInterval = types.StringValue(m.RealertInterval)
+
+	if prior.Name.IsNull() {
+		b.Notify = UnorderedListValue{ListValue: b.EffectiveNotify}
+		b.Tags = TagListValue{ListValue: b.EffectiveTags}
+		b.RealertInterval = b.EffectiveRealertInterval
+		return
+	}
+
+	b.Notify = unorderedStringListNull()
+	if !prior.Notify.IsNull() {
+		b.Notify = UnorderedListValue{ListValue: b.EffectiveNotify}
+		// The api sends alerts to the default list when there is nowhere else
+		// to send them, so an empty list is kept as it was planned
+		if len(prior.Notify.Elements()) == 0 && slices.Equal(m.Notify, []string{"default"}) {
+			b.Notify = prior.Notify
+		}
+	}
+	b.Tags = TagListValue{ListValue: types.ListNull(types.StringType)}
+	if !prior.Tags.IsNull() {
+		b.Tags = TagListValue{ListValue: b.EffectiveTags}
+	}
+	b.RealertInterval = types.StringNull()
+	if !prior.RealertInterval.IsNull() {
+		b.RealertInterval = b.EffectiveRealertInterval
+	}
+}
+
+// runbookNote appends a link to the runbook to the note, so that it is
+// included in alerts.
+func runbookNote(note, url string) string {
+	if url == "" {
+		return note
+	}
+	line := "Runbook: " + url
+	if note == "" {
+		return line
+	}
+	return note + "\n\n" + line
+}
+
+// splitRunbookNote separates the runbook link added by runbookNote back out of
+// the note.
+func splitRunbookNote(note string, prior types.String) (types.String, types.String) {
+	url := prior.ValueString()
+	if url == "" {
+		return types.StringValue(note), types.StringNull()
+	}
+	if note == runbookNote("", url) {
+		return types.StringValue(""), prior
+	}
+	if base, ok := strings.CutSuffix(note, "\n\nRunbook: "+url); ok {
+		return types.StringValue(base), prior
+	}
+	return types.StringValue(note), types.StringNull()
+}
+
+// optionalString converts an empty string to null.
+func optionalString(in string) types.String {
+	if in == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(in)
+}
+
+func dashboardUrl(key string) string {
+	return fmt.Sprintf("https://cronitor.io/app/monitors/%s", key)
+}
+
+// setTelemetry sets the urls that pings for the monitor are sent to.
+func (h *HeartbeatMonitorModel) setTelemetry(c *cronitor.Client, m *cronitor.Monitor) {
+	base := c.TelemetryUrl(*m.Key)
+	h.TelemetryUrl = types.StringValue(base)
+	h.TelemetryUrls = telemetryUrls(c, m)
+	h.RunUrl = types.StringValue(base + "?state=run")
+	h.CompleteUrl = types.StringValue(base + "?state=complete")
+	h.FailUrl = types.StringValue(base + "?state=fail")
+}
+
+// telemetryUrls builds a ping url for each of the monitor's environments.
+func telemetryUrls(c *cronitor.Client, m *cronitor.Monitor) types.Map {
+	elems := map[string]attr.Value{}
+	for _, env := range m.Environments {
+		elems[env] = types.StringValue(fmt.Sprintf("%s?env=%s", c.TelemetryUrl(*m.Key), url.QueryEscape(env)))
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
+
+// intPointer returns nil when the value isn't set, so it is left out of the
+// request and the api default is used.
+func intPointer(in types.Int32) *int {
+	if in.IsNull() || in.IsUnknown() {
+		return nil
+	}
+	out := int(in.ValueInt32())
+	return &out
+}
+
+func int32Value(in *int) types.Int32 {
+	if in == nil {
+		return types.Int32Null()
+	}
+	return types.Int32Value(int32(*in))
+}
+
+// optionalInt32 returns the api value of an attribute that is left to the
+// cronitor default when it isn't set. The default isn't kept when the attribute
+// wasn't set before, so that it doesn't show as a diff, unless the monitor was
+// imported and there is nothing to go on.
+func optionalInt32(in *int, prior types.Int32, imported bool) types.Int32 {
+	if prior.IsNull() && !imported {
+		return types.Int32Null()
+	}
+	return int32Value(in)
+}
+
+func stringValue(in *string) string {
+	if in == nil {
+		return ""
+	}
+	return *in
+}
+
 func toStringMap(in types.Map) map[string]string {
 	temp := map[string]types.String{}
 	in.ElementsAs(context.Background(), &temp, false)
@@ -96,45 +367,171 @@ func toStringMap(in types.Map) map[string]string {
 	return out
 }
 
-func toHttpMonitor(m *cronitor.Monitor) HttpMonitorModel {
+func toHttpRequest(in types.Object) (HttpRequestModel, bool) {
+	out := HttpRequestModel{}
+	if in.IsNull() || in.IsUnknown() {
+		return out, false
+	}
+	in.As(context.Background(), &out, basetypes.ObjectAsOptions{})
+	return out, true
+}
+
+// joinHeaderValues combines repeated header values into a single header, which
+// is how the api stores them.
+func joinHeaderValues(name string, values []string) string {
+	if name == "cookie" {
+		return strings.Join(values, "; ")
+	}
+	return strings.Join(values, ", ")
+}
+
+// toHttpMonitor converts an api monitor into the resource model. The prior model
+// is used to work out which values were set via convenience attributes, so they
+// can be split back out of the raw request fields they are compiled into.
+func toHttpMonitor(m *cronitor.Monitor, prior HttpMonitorModel) HttpMonitorModel {
+	priorRequest, _ := toHttpRequest(prior.Request)
+	expectedStatusCode := types.Int32Null()
+	if !prior.ExpectedStatusCode.IsNull() && takeAssertion(&m.Assertions, statusCodeAssertion(prior.ExpectedStatusCode.ValueInt32())) {
+		expectedStatusCode = prior.ExpectedStatusCode
+	}
+	expectedStatusCodes := []int32{}
+	for _, code := range toInt32Slice(prior.ExpectedStatusCodes) {
+		if takeAssertion(&m.Assertions, statusCodeAssertion(code)) {
+			expectedStatusCodes = append(expectedStatusCodes, code)
+		}
+	}
+	maxResponseTime := types.Int32Null()
+	if !prior.MaxResponseTimeMs.IsNull() && takeAssertion(&m.Assertions, responseTimeAssertion(prior.MaxResponseTimeMs.ValueInt32())) {
+		maxResponseTime = prior.MaxResponseTimeMs
+	}
+	sslExpiresWithin := types.Int32Null()
+	if !prior.SslExpiresWithin.IsNull() && takeAssertion(&m.Assertions, sslExpiryAssertion(prior.SslExpiresWithin.ValueInt32())) {
+		sslExpiresWithin = prior.SslExpiresWithin
+	}
+	jsonAssertions := []JsonAssertionModel{}
+	for _, a := range toJsonAssertions(prior.JsonAssertions) {
+		if takeAssertion(&m.Assertions, a.assertion()) {
+			jsonAssertions = append(jsonAssertions, a)
+		}
+	}
+	headerAssertions := []HeaderAssertionModel{}
+	for _, a := range toHeaderAssertions(prior.HeaderAssertions) {
+		if takeAssertion(&m.Assertions, a.assertion()) {
+			headerAssertions = append(headerAssertions, a)
+		}
+	}
+
 	out := HttpMonitorModel{
 		BaseMonitorModel: BaseMonitorModel{
-			Key:             types.StringValue(*m.Key),
-			Name:            types.StringValue(m.Name),
-			Disabled:        types.BoolValue(m.Disabled),
-			Paused:          types.BoolValue(m.Paused),
-			Schedule:        types.StringValue(m.Schedule),
-			Notify:          stringSlice(m.Notify),
-			Tags:            stringSlice(m.Tags),
-			RealertInterval: types.StringValue(m.RealertInterval),
-			Environments:    stringSlice(m.Environments),
+			Key:                  types.StringValue(*m.Key),
+			Name:                 types.StringValue(m.Name),
+			Disabled:             types.BoolValue(m.Disabled),
+			Paused:               types.BoolValue(m.Paused),
+			Schedule:             types.StringValue(m.Schedule),
+			ScheduleSpec:         types.ObjectNull(scheduleSpecType.AttrTypes),
+			Environments:         unorderedStringList(m.Environments),
+			DashboardUrl:         types.StringValue(dashboardUrl(*m.Key)),
+			Passing:              types.BoolValue(m.Passing),
+			Running:              types.BoolValue(m.Running),
+			Initialized:          types.BoolValue(m.Initialized),
+			SnoozeUntil:          prior.SnoozeUntil,
+			PauseOnDestroy:       types.BoolValue(prior.PauseOnDestroy.ValueBool()),
+			DeletionProtection:   types.BoolValue(prior.DeletionProtection.ValueBool()),
+			StatusPageProtection: types.BoolValue(prior.StatusPageProtection.ValueBool()),
+			AdoptExisting:        types.BoolValue(prior.AdoptExisting.ValueBool()),
+			Timeouts:             prior.Timeouts,
 		},
-		Assertions:      stringSlice(m.Assertions),
+		Assertions:         unorderedStringList(m.Assertions),
+		BearerToken:        types.StringNull(),
+		ExpectedStatusCode: expectedStatusCode,
+		MaxResponseTimeMs:  maxResponseTime,
+		SslExpiresWithin:   sslExpiresWithin,
+	}
+	out.Note, out.RunbookUrl = splitRunbookNote(stringValue(m.Note), prior.RunbookUrl)
+	out.EnvironmentOverrides = fromEnvironmentOverrides(m.EnvironmentOverrides, prior.EnvironmentOverrides)
+	out.Escalations = fromAlertRules(m.AlertRules)
+	out.setInherited(m, prior.BaseMonitorModel)
+	out.ExpectedStatusCodes = types.ListNull(types.Int32Type)
+	if len(expectedStatusCodes) > 0 {
+		out.ExpectedStatusCodes, _ = types.ListValueFrom(context.Background(), types.Int32Type, expectedStatusCodes)
+	}
+	out.JsonAssertions, _ = types.ListValueFrom(context.Background(), jsonAssertionType, jsonAssertions)
+	out.HeaderAssertions, _ = types.ListValueFrom(context.Background(), headerAssertionType, headerAssertions)
+
+	out.Graphql = types.ObjectNull(graphqlType.AttrTypes)
+	body := m.Request.Body
+	if g, ok := toGraphqlModel(prior.Graphql); ok {
+		if parsed, ok := fromGraphqlBody(m.Request.Body, g); ok {
+			body = ""
+			out.Graphql, _ = types.ObjectValueFrom(context.Background(), graphqlType.AttrTypes, parsed)
+			if _, set := toStringMap(priorRequest.Headers)[contentTypeHeader]; !set && m.Request.Headers[contentTypeHeader] == graphqlContentType {
+				delete(m.Request.Headers, contentTypeHeader)
+			}
+		}
+	}
+
+	out.HeadersMulti = types.MapNull(types.ListType{ElemType: types.StringType})
+	if !prior.HeadersMulti.IsNull() {
+		elems := map[string]attr.Value{}
+		for key, vals := range toStringListMap(prior.HeadersMulti) {
+			if val, ok := m.Request.Headers[key]; ok && val == joinHeaderValues(key, vals) {
+				elems[key] = stringSlice(vals)
+				delete(m.Request.Headers, key)
+			}
+		}
+		out.HeadersMulti = types.MapValueMust(types.ListType{ElemType: types.StringType}, elems)
+	}
+
+	if !prior.BearerToken.IsNull() {
+		if auth, ok := m.Request.Headers[authorizationHeader]; ok && strings.HasPrefix(auth, bearerPrefix) {
+			out.BearerToken = types.StringValue(strings.TrimPrefix(auth, bearerPrefix))
+			delete(m.Request.Headers, authorizationHeader)
+		}
+	}
+
+	if spec, ok := toScheduleSpec(prior.ScheduleSpec); ok {
+		out.ScheduleSpec, _ = types.ObjectValueFrom(context.Background(), scheduleSpecType.AttrTypes, fromSchedule(m.Schedule, spec))
+	}
+	if m.Timezone != nil {
+		out.Timezone = types.StringValue(*m.Timezone)
+	}
+	// A snoozed monitor is paused by the api until the snooze ends
+	if snoozed(prior.SnoozeUntil) {
+		out.Paused = prior.Paused
+	}
+	out.ScheduleTolerance = optionalInt32(m.ScheduleTolerance, prior.ScheduleTolerance, prior.Name.IsNull())
+	out.FailureTolerance = optionalInt32(m.FailureTolerance, prior.FailureTolerance, prior.Name.IsNull())
+	out.GraceSeconds = optionalInt32(m.GraceSeconds, prior.GraceSeconds, prior.Name.IsNull())
+	out.Position = int32Value(m.Position)
+	if m.Group != nil {
+		out.Group = types.StringValue(*m.Group)
+	}
+	request := HttpRequestModel{
 		Url:             types.StringValue(m.Request.URL),
 		Method:          types.StringValue(m.Request.Method),
 		Headers:         types.MapNull(types.StringType),
 		Cookies:         types.MapNull(types.StringType),
-		Body:            types.StringNull(),
+		Body:            NewRequestBodyValue(body),
 		TimeoutSeconds:  types.Int32Value(int32(m.Request.TimeoutSeconds)),
-		Regions:         stringSlice(m.Request.Regions),
+		Regions:         unorderedStringList(m.Request.Regions),
 		FollowRedirects: types.BoolValue(m.Request.FollowRedirects),
 		VerifySsl:       types.BoolValue(m.Request.VerifySsl),
+		IPVersion:       types.StringValue(ipVersionAny),
 	}
-
-	if m.Timezone != nil {
-		out.Timezone = types.StringValue(*m.Timezone)
-	}
-	if m.ScheduleTolerance != nil {
-		out.ScheduleTolerance = types.Int32Value(int32(*m.ScheduleTolerance))
+	if m.Request.MaxRedirects != nil {
+		request.MaxRedirects = types.Int32Value(int32(*m.Request.MaxRedirects))
 	}
-	if m.FailureTolerance != nil {
-		out.FailureTolerance = types.Int32Value(int32(*m.FailureTolerance))
+	if m.Request.IPVersion != "" {
+		request.IPVersion = types.StringValue(m.Request.IPVersion)
 	}
-	if m.GraceSeconds != nil {
-		out.GraceSeconds = types.Int32Value(int32(*m.GraceSeconds))
+	if m.Request.ClientCert != "" {
+		request.ClientCertPem = types.StringValue(m.Request.ClientCert)
 	}
-	if m.Group != nil {
-		out.Group = types.StringValue(*m.Group)
+	// The api doesn't return the private key, so keep the one we last sent
+	if m.Request.ClientKey != "" {
+		request.ClientKeyPem = types.StringValue(m.Request.ClientKey)
+	} else {
+		request.ClientKeyPem = priorRequest.ClientKeyPem
 	}
 
 	if len(m.Request.Headers) > 0 {
@@ -142,55 +539,60 @@ func toHttpMonitor(m *cronitor.Monitor) HttpMonitorModel {
 		for key, val := range m.Request.Headers {
 			elems[key] = types.StringValue(val)
 		}
-		out.Headers = types.MapValueMust(types.StringType, elems)
+		request.Headers = types.MapValueMust(types.StringType, elems)
 	}
 	if len(m.Request.Cookies) > 0 {
 		elems := map[string]attr.Value{}
 		for key, val := range m.Request.Cookies {
 			elems[key] = types.StringValue(val)
 		}
-		out.Cookies = types.MapValueMust(types.StringType, elems)
+		request.Cookies = types.MapValueMust(types.StringType, elems)
 	}
+	out.Request, _ = types.ObjectValueFrom(context.Background(), httpRequestType.AttrTypes, request)
 
 	return out
 }
 
 func httpToMonitorRequest(data HttpMonitorModel) *cronitor.Monitor {
+	request, _ := toHttpRequest(data.Request)
 	out := &cronitor.Monitor{
 		Name:         data.Name.ValueString(),
 		Assertions:   toStringSlice(data.Assertions),
 		Disabled:     data.Disabled.ValueBool(),
-		Paused:       data.Disabled.ValueBool(),
+		Paused:       data.Paused.ValueBool(),
 		Notify:       toStringSlice(data.Notify),
-		Tags:         toStringSlice(data.Tags),
+		Tags:         data.Tags.Tags(),
 		Environments: toStringSlice(data.Environments),
 		Type:         "check",
 		Platform:     "http",
 		Request: &cronitor.Request{
-			URL:             data.Url.ValueString(),
-			Method:          data.Method.ValueString(),
-			Headers:         toStringMap(data.Headers),
-			Cookies:         toStringMap(data.Cookies),
-			Body:            data.Body.ValueString(),
-			Regions:         toStringSlice(data.Regions),
-			TimeoutSeconds:  int(data.TimeoutSeconds.ValueInt32()),
-			FollowRedirects: data.FollowRedirects.ValueBool(),
-			VerifySsl:       data.VerifySsl.ValueBool(),
+			URL:             request.Url.ValueString(),
+			Method:          request.Method.ValueString(),
+			Headers:         toStringMap(request.Headers),
+			Cookies:         toStringMap(request.Cookies),
+			Body:            request.Body.ValueString(),
+			Regions:         toStringSlice(request.Regions),
+			TimeoutSeconds:  int(request.TimeoutSeconds.ValueInt32()),
+			FollowRedirects: request.FollowRedirects.ValueBool(),
+			VerifySsl:       request.VerifySsl.ValueBool(),
+			ClientCert:      request.ClientCertPem.ValueString(),
+			ClientKey:       request.ClientKeyPem.ValueString(),
 		},
 	}
-	if out.RealertInterval == "" {
-		out.RealertInterval = "every 8 hours"
-	}
+	out.RealertInterval = data.RealertInterval.ValueString()
 	if data.Schedule.ValueString() != "" {
 		out.Schedule = data.Schedule.ValueString()
 	}
+	if spec, ok := toScheduleSpec(data.ScheduleSpec); ok {
+		out.Schedule = spec.schedule()
+	}
 
-	g := int(data.GraceSeconds.ValueInt32())
-	out.GraceSeconds = &g
-	st := int(data.ScheduleTolerance.ValueInt32())
-	out.ScheduleTolerance = &st
-	ft := int(data.FailureTolerance.ValueInt32())
-	out.FailureTolerance = &ft
+	out.EnvironmentOverrides = toEnvironmentOverrides(data.EnvironmentOverrides)
+	out.AlertRules = toAlertRules(data.Escalations)
+	out.GraceSeconds = intPointer(data.GraceSeconds)
+	out.Position = intPointer(data.Position)
+	out.ScheduleTolerance = intPointer(data.ScheduleTolerance)
+	out.FailureTolerance = intPointer(data.FailureTolerance)
 	if data.Timezone.ValueString() != "" {
 		tz := data.Timezone.ValueString()
 		out.Timezone = &tz
@@ -199,37 +601,116 @@ func httpToMonitorRequest(data HttpMonitorModel) *cronitor.Monitor {
 		grp := data.Group.ValueString()
 		out.Group = &grp
 	}
+	if data.Key.ValueString() != "" {
+		key := data.Key.ValueString()
+		out.Key = &key
+	}
+	if !data.Note.IsUnknown() || data.RunbookUrl.ValueString() != "" {
+		note := runbookNote(data.Note.ValueString(), data.RunbookUrl.ValueString())
+		out.Note = &note
+	}
+	if v := request.IPVersion.ValueString(); v != "" && v != ipVersionAny {
+		out.Request.IPVersion = v
+	}
+	if !request.MaxRedirects.IsNull() && !request.MaxRedirects.IsUnknown() {
+		mr := int(request.MaxRedirects.ValueInt32())
+		out.Request.MaxRedirects = &mr
+	}
+	for key, vals := range toStringListMap(data.HeadersMulti) {
+		out.Request.Headers[key] = joinHeaderValues(key, vals)
+	}
+	if g, ok := toGraphqlModel(data.Graphql); ok {
+		out.Request.Body = graphqlBody(g)
+		if _, set := out.Request.Headers[contentTypeHeader]; !set {
+			out.Request.Headers[contentTypeHeader] = graphqlContentType
+		}
+	}
+	if data.BearerToken.ValueString() != "" {
+		out.Request.Headers[authorizationHeader] = bearerPrefix + data.BearerToken.ValueString()
+	}
+	if !data.ExpectedStatusCode.IsNull() && !data.ExpectedStatusCode.IsUnknown() {
+		out.Assertions = append(out.Assertions, statusCodeAssertion(data.ExpectedStatusCode.ValueInt32()))
+	}
+	for _, code := range toInt32Slice(data.ExpectedStatusCodes) {
+		out.Assertions = append(out.Assertions, statusCodeAssertion(code))
+	}
+	if !data.MaxResponseTimeMs.IsNull() && !data.MaxResponseTimeMs.IsUnknown() {
+		out.Assertions = append(out.Assertions, responseTimeAssertion(data.MaxResponseTimeMs.ValueInt32()))
+	}
+	if !data.SslExpiresWithin.IsNull() && !data.SslExpiresWithin.IsUnknown() {
+		out.Assertions = append(out.Assertions, sslExpiryAssertion(data.SslExpiresWithin.ValueInt32()))
+	}
+	for _, a := range toJsonAssertions(data.JsonAssertions) {
+		out.Assertions = append(out.Assertions, a.assertion())
+	}
+	for _, a := range toHeaderAssertions(data.HeaderAssertions) {
+		out.Assertions = append(out.Assertions, a.assertion())
+	}
 
 	return out
 }
 
-func toHeartbeatMonitor(m *cronitor.Monitor) HeartbeatMonitorModel {
+func toHeartbeatMonitor(m *cronitor.Monitor, prior HeartbeatMonitorModel) HeartbeatMonitorModel {
 	out := HeartbeatMonitorModel{
 		BaseMonitorModel: BaseMonitorModel{
-			Key:             types.StringValue(*m.Key),
-			Name:            types.StringValue(m.Name),
-			Disabled:        types.BoolValue(m.Disabled),
-			Paused:          types.BoolValue(m.Paused),
-			Schedule:        types.StringValue(m.Schedule),
-			Notify:          stringSlice(m.Notify),
-			Tags:            stringSlice(m.Tags),
-			RealertInterval: types.StringValue(m.RealertInterval),
-			Environments:    stringSlice(m.Environments),
+			Key:                  types.StringValue(*m.Key),
+			Name:                 types.StringValue(m.Name),
+			Disabled:             types.BoolValue(m.Disabled),
+			Paused:               types.BoolValue(m.Paused),
+			Schedule:             types.StringValue(m.Schedule),
+			ScheduleSpec:         types.ObjectNull(scheduleSpecType.AttrTypes),
+			Environments:         unorderedStringList(m.Environments),
+			DashboardUrl:         types.StringValue(dashboardUrl(*m.Key)),
+			Passing:              types.BoolValue(m.Passing),
+			Running:              types.BoolValue(m.Running),
+			Initialized:          types.BoolValue(m.Initialized),
+			SnoozeUntil:          prior.SnoozeUntil,
+			PauseOnDestroy:       types.BoolValue(prior.PauseOnDestroy.ValueBool()),
+			DeletionProtection:   types.BoolValue(prior.DeletionProtection.ValueBool()),
+			StatusPageProtection: types.BoolValue(prior.StatusPageProtection.ValueBool()),
+			AdoptExisting:        types.BoolValue(prior.AdoptExisting.ValueBool()),
+			Timeouts:             prior.Timeouts,
 		},
+		MaxDurationSeconds: types.Int32Null(),
+		ScheduleType:       prior.ScheduleType,
+		EverySeconds:       types.Int32Null(),
 	}
 
-	if m.Timezone != nil {
-		out.Timezone = types.StringValue(*m.Timezone)
+	out.Note, out.RunbookUrl = splitRunbookNote(stringValue(m.Note), prior.RunbookUrl)
+	out.EnvironmentOverrides = fromEnvironmentOverrides(m.EnvironmentOverrides, prior.EnvironmentOverrides)
+	out.Escalations = fromAlertRules(m.AlertRules)
+	out.setInherited(m, prior.BaseMonitorModel)
+	if !prior.MaxDurationSeconds.IsNull() && takeAssertion(&m.Assertions, durationAssertion(prior.MaxDurationSeconds.ValueInt32())) {
+		out.MaxDurationSeconds = prior.MaxDurationSeconds
 	}
-	if m.ScheduleTolerance != nil {
-		out.ScheduleTolerance = types.Int32Value(int32(*m.ScheduleTolerance))
+	if prior.Name.IsNull() {
+		if seconds, ok := findDurationAssertion(m.Assertions); ok {
+			out.MaxDurationSeconds = types.Int32Value(seconds)
+		}
+	}
+	if !prior.EverySeconds.IsNull() {
+		spec := fromSchedule(m.Schedule, ScheduleSpecModel{
+			Type:       types.StringValue(scheduleTypeInterval),
+			Seconds:    prior.EverySeconds,
+			Expression: types.StringNull(),
+		})
+		out.ScheduleType = spec.Type
+		out.EverySeconds = spec.Seconds
 	}
-	if m.FailureTolerance != nil {
-		out.FailureTolerance = types.Int32Value(int32(*m.FailureTolerance))
+	if spec, ok := toScheduleSpec(prior.ScheduleSpec); ok {
+		out.ScheduleSpec, _ = types.ObjectValueFrom(context.Background(), scheduleSpecType.AttrTypes, fromSchedule(m.Schedule, spec))
 	}
-	if m.GraceSeconds != nil {
-		out.GraceSeconds = types.Int32Value(int32(*m.GraceSeconds))
+	if m.Timezone != nil {
+		out.Timezone = types.StringValue(*m.Timezone)
 	}
+	// A snoozed monitor is paused by the api until the snooze ends
+	if snoozed(prior.SnoozeUntil) {
+		out.Paused = prior.Paused
+	}
+	out.ScheduleTolerance = optionalInt32(m.ScheduleTolerance, prior.ScheduleTolerance, prior.Name.IsNull())
+	out.FailureTolerance = optionalInt32(m.FailureTolerance, prior.FailureTolerance, prior.Name.IsNull())
+	out.GraceSeconds = optionalInt32(m.GraceSeconds, prior.GraceSeconds, prior.Name.IsNull())
+	out.Position = int32Value(m.Position)
 	if m.Group != nil {
 		out.Group = types.StringValue(*m.Group)
 	}
@@ -241,27 +722,37 @@ func heartbeatToMonitorRequest(data HeartbeatMonitorModel) *cronitor.Monitor {
 	out := &cronitor.Monitor{
 		Name:         data.Name.ValueString(),
 		Disabled:     data.Disabled.ValueBool(),
-		Paused:       data.Disabled.ValueBool(),
+		Paused:       data.Paused.ValueBool(),
 		Notify:       toStringSlice(data.Notify),
-		Tags:         toStringSlice(data.Tags),
+		Tags:         data.Tags.Tags(),
 		Environments: toStringSlice(data.Environments),
 		Type:         "heartbeat",
 		Platform:     "linux",
 	}
-	if out.RealertInterval == "" {
-		out.RealertInterval = "every 8 hours"
+	if !data.MaxDurationSeconds.IsNull() && !data.MaxDurationSeconds.IsUnknown() {
+		out.Assertions = append(out.Assertions, durationAssertion(data.MaxDurationSeconds.ValueInt32()))
+	}
+	if data.ScheduleType.ValueString() == scheduleTypeInterval && !data.EverySeconds.IsNull() {
+		out.Schedule = ScheduleSpecModel{
+			Type:    data.ScheduleType,
+			Seconds: data.EverySeconds,
+		}.schedule()
 	}
+	out.RealertInterval = data.RealertInterval.ValueString()
 
 	if data.Schedule.ValueString() != "" {
 		out.Schedule = data.Schedule.ValueString()
 	}
+	if spec, ok := toScheduleSpec(data.ScheduleSpec); ok {
+		out.Schedule = spec.schedule()
+	}
 
-	g := int(data.GraceSeconds.ValueInt32())
-	out.GraceSeconds = &g
-	st := int(data.ScheduleTolerance.ValueInt32())
-	out.ScheduleTolerance = &st
-	ft := int(data.FailureTolerance.ValueInt32())
-	out.FailureTolerance = &ft
+	out.EnvironmentOverrides = toEnvironmentOverrides(data.EnvironmentOverrides)
+	out.AlertRules = toAlertRules(data.Escalations)
+	out.GraceSeconds = intPointer(data.GraceSeconds)
+	out.Position = intPointer(data.Position)
+	out.ScheduleTolerance = intPointer(data.ScheduleTolerance)
+	out.FailureTolerance = intPointer(data.FailureTolerance)
 	if data.Timezone.ValueString() != "" {
 		tz := data.Timezone.ValueString()
 		out.Timezone = &tz
@@ -270,19 +761,40 @@ func heartbeatToMonitorRequest(data HeartbeatMonitorModel) *cronitor.Monitor {
 		grp := data.Group.ValueString()
 		out.Group = &grp
 	}
+	if data.Key.ValueString() != "" {
+		key := data.Key.ValueString()
+		out.Key = &key
+	}
+	if !data.Note.IsUnknown() || data.RunbookUrl.ValueString() != "" {
+		note := runbookNote(data.Note.ValueString(), data.RunbookUrl.ValueString())
+		out.Note = &note
+	}
 
 	return out
 }
 
 func toNotificationList(l *cronitor.NotificationList) NotificationListModel {
+	monitors := slices.Clone(l.Monitors)
+	slices.Sort(monitors)
+	attached, _ := types.ListValueFrom(context.Background(), types.StringType, append([]string{}, monitors...))
+
 	return NotificationListModel{
 		Name:      types.StringValue(l.Name),
 		Key:       types.StringValue(l.Key),
-		Emails:    stringSlice(l.Notifications.Emails),
-		Slack:     stringSlice(l.Notifications.Slack),
-		Pagerduty: stringSlice(l.Notifications.Pagerduty),
-		Phones:    stringSlice(l.Notifications.Phones),
-		Webhooks:  stringSlice(l.Notifications.Webhooks),
+		Emails:    unorderedStringList(l.Notifications.Emails),
+		Slack:     unorderedStringList(l.Notifications.Slack),
+		Pagerduty: unorderedStringList(l.Notifications.Pagerduty),
+		Phones:    unorderedStringList(l.Notifications.Phones),
+		Webhooks:  unorderedStringList(l.Notifications.Webhooks),
+		Webhook:   fromWebhooks(l.Notifications.CustomWebhooks),
+		Sns:       fromSnsTopics(l.Notifications.Sns),
+		Events:    fromNotificationEvents(l.Events),
+
+		QuietHours: fromQuietHours(l.QuietHours),
+		IsDefault:  types.BoolValue(l.IsDefault),
+
+		AttachedMonitorKeys:  attached,
+		AttachedMonitorCount: types.Int32Value(int32(len(monitors))),
 	}
 }
 
@@ -296,31 +808,358 @@ func listToListRequest(data NotificationListModel) *cronitor.NotificationList {
 			Pagerduty: toStringSlice(data.Pagerduty),
 			Phones:    toStringSlice(data.Phones),
 			Webhooks:  toStringSlice(data.Webhooks),
+
+			CustomWebhooks: toWebhooks(data.Webhook),
+			Sns:            toSnsTopics(data.Sns),
 		},
+		Events:     toNotificationEvents(data.Events),
+		QuietHours: toQuietHours(data.QuietHours),
+		IsDefault:  data.IsDefault.ValueBool(),
 	}
 }
 
-func fixSliceOrder[T comparable](correct []T, incorrect *[]T) {
-	if incorrect == nil {
-		*incorrect = []T{}
+type GroupModel struct {
+	Key                    types.String       `tfsdk:"key"`
+	Name                   types.String       `tfsdk:"name"`
+	DefaultNotify          types.List         `tfsdk:"default_notify"`
+	DefaultTags            types.List         `tfsdk:"default_tags"`
+	DefaultRealertInterval types.String       `tfsdk:"default_realert_interval"`
+	MonitorKeys            UnorderedListValue `tfsdk:"monitor_keys"`
+	Timeouts               timeouts.Value     `tfsdk:"timeouts"`
+}
+
+func toGroup(g *cronitor.Group, prior GroupModel) GroupModel {
+	out := GroupModel{
+		Key:                    types.StringValue(g.Key),
+		Name:                   types.StringValue(g.Name),
+		DefaultNotify:          types.ListNull(types.StringType),
+		DefaultTags:            types.ListNull(types.StringType),
+		DefaultRealertInterval: types.StringNull(),
+		MonitorKeys:            unorderedStringListNull(),
+		Timeouts:               prior.Timeouts,
 	}
+	// Membership is only managed from the group when the keys have been set
+	if !prior.MonitorKeys.IsNull() {
+		out.MonitorKeys = unorderedStringList(g.Monitors)
+	}
+	if g.Defaults != nil {
+		out.DefaultNotify = stringSlice(g.Defaults.Notify)
+		out.DefaultTags = stringSlice(g.Defaults.Tags)
+		if g.Defaults.RealertInterval != "" {
+			out.DefaultRealertInterval = types.StringValue(g.Defaults.RealertInterval)
+		}
+	}
+	return out
+}
 
-	if len(correct) != len(*incorrect) {
-		return
+func groupToGroupRequest(data GroupModel) *cronitor.Group {
+	return &cronitor.Group{
+		Key:      data.Key.ValueString(),
+		Name:     data.Name.ValueString(),
+		Monitors: toStringSlice(data.MonitorKeys),
+		Defaults: &cronitor.GroupDefaults{
+			Notify:          toStringSlice(data.DefaultNotify),
+			Tags:            toStringSlice(data.DefaultTags),
+			RealertInterval: data.DefaultRealertInterval.ValueString(),
+		},
 	}
+}
 
-	if correct == nil {
-		*incorrect = nil
-		return
+type StatusPageModel struct {
+	Key                types.String   `tfsdk:"key"`
+	Name               types.String   `tfsdk:"name"`
+	Subdomain          types.String   `tfsdk:"subdomain"`
+	CustomDomain       types.String   `tfsdk:"custom_domain"`
+	LogoUrl            types.String   `tfsdk:"logo_url"`
+	FaviconUrl         types.String   `tfsdk:"favicon_url"`
+	BrandColor         types.String   `tfsdk:"brand_color"`
+	Theme              types.String   `tfsdk:"theme"`
+	Sections           types.List     `tfsdk:"section"`
+	CustomDomainTarget types.String   `tfsdk:"custom_domain_target"`
+	TLSStatus          types.String   `tfsdk:"tls_status"`
+	TLSReady           types.Bool     `tfsdk:"tls_ready"`
+	Url                types.String   `tfsdk:"url"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func toStatusPage(p *cronitor.StatusPage, prior StatusPageModel) StatusPageModel {
+	out := StatusPageModel{
+		Key:                types.StringValue(p.Key),
+		Name:               types.StringValue(p.Name),
+		Subdomain:          types.StringValue(p.Subdomain),
+		CustomDomain:       types.StringPointerValue(p.CustomDomain),
+		LogoUrl:            optionalString(p.LogoUrl),
+		FaviconUrl:         optionalString(p.FaviconUrl),
+		BrandColor:         optionalString(p.BrandColor),
+		Theme:              optionalString(p.Theme),
+		Sections:           fromStatusPageSections(p.Sections),
+		CustomDomainTarget: types.StringValue(p.CustomDomainTarget),
+		TLSStatus:          types.StringValue(p.TLSStatus),
+		TLSReady:           types.BoolValue(p.TLSStatus == "issued"),
+		Url:                types.StringValue(fmt.Sprintf("https://%s.cronitorstatus.com", p.Subdomain)),
+		Timeouts:           prior.Timeouts,
 	}
+	if p.CustomDomain != nil && *p.CustomDomain != "" {
+		out.Url = types.StringValue(fmt.Sprintf("https://%s", *p.CustomDomain))
+	} else {
+		out.CustomDomain = types.StringNull()
+	}
+	return out
+}
 
-	for _, i := range *incorrect {
-		if !slices.Contains(correct, i) {
-			return
-		}
+func statusPageToStatusPageRequest(data StatusPageModel) *cronitor.StatusPage {
+	// The custom domain is always sent so that removing it clears it
+	domain := data.CustomDomain.ValueString()
+	return &cronitor.StatusPage{
+		Key:          data.Key.ValueString(),
+		Name:         data.Name.ValueString(),
+		Subdomain:    data.Subdomain.ValueString(),
+		CustomDomain: &domain,
+		LogoUrl:      data.LogoUrl.ValueString(),
+		FaviconUrl:   data.FaviconUrl.ValueString(),
+		BrandColor:   data.BrandColor.ValueString(),
+		Theme:        data.Theme.ValueString(),
+		Sections:     toStatusPageSections(data.Sections),
+	}
+}
+
+const (
+	subscriberTypeEmail   = "email"
+	subscriberTypeWebhook = "webhook"
+)
+
+var statusPageSectionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":     types.StringType,
+		"monitors": types.ListType{ElemType: types.StringType},
+	},
+}
+
+type StatusPageSectionModel struct {
+	Name     types.String `tfsdk:"name"`
+	Monitors types.List   `tfsdk:"monitors"`
+}
+
+func toStatusPageSections(in types.List) []cronitor.StatusPageSection {
+	models := []StatusPageSectionModel{}
+	if !in.IsNull() && !in.IsUnknown() {
+		in.ElementsAs(context.Background(), &models, false)
+	}
+
+	out := []cronitor.StatusPageSection{}
+	for _, m := range models {
+		out = append(out, cronitor.StatusPageSection{
+			Name:     m.Name.ValueString(),
+			Monitors: toStringSlice(m.Monitors),
+		})
+	}
+	return out
+}
+
+func fromStatusPageSections(in []cronitor.StatusPageSection) types.List {
+	models := []StatusPageSectionModel{}
+	for _, s := range in {
+		monitors, _ := types.ListValueFrom(context.Background(), types.StringType, append([]string{}, s.Monitors...))
+		models = append(models, StatusPageSectionModel{
+			Name:     types.StringValue(s.Name),
+			Monitors: monitors,
+		})
+	}
+	out, _ := types.ListValueFrom(context.Background(), statusPageSectionType, models)
+	return out
+}
+
+var statusPageIncidentUpdateType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"status":  types.StringType,
+		"message": types.StringType,
+	},
+}
+
+type StatusPageIncidentModel struct {
+	ID         types.String       `tfsdk:"id"`
+	StatusPage types.String       `tfsdk:"status_page"`
+	Title      types.String       `tfsdk:"title"`
+	Status     types.String       `tfsdk:"status"`
+	Components UnorderedListValue `tfsdk:"components"`
+	Updates    types.List         `tfsdk:"update"`
+	Timeouts   timeouts.Value     `tfsdk:"timeouts"`
+}
+
+type StatusPageIncidentUpdateModel struct {
+	Status  types.String `tfsdk:"status"`
+	Message types.String `tfsdk:"message"`
+}
+
+func toStatusPageIncident(i *cronitor.StatusPageIncident, prior StatusPageIncidentModel) StatusPageIncidentModel {
+	updates := []StatusPageIncidentUpdateModel{}
+	for _, u := range i.Updates {
+		updates = append(updates, StatusPageIncidentUpdateModel{
+			Status:  types.StringValue(u.Status),
+			Message: types.StringValue(u.Message),
+		})
+	}
+	updateList, _ := types.ListValueFrom(context.Background(), statusPageIncidentUpdateType, updates)
+
+	out := StatusPageIncidentModel{
+		ID:         types.StringValue(i.ID),
+		StatusPage: prior.StatusPage,
+		Title:      types.StringValue(i.Title),
+		Status:     types.StringValue(i.Status),
+		Components: unorderedStringListNull(),
+		Updates:    updateList,
+		Timeouts:   prior.Timeouts,
+	}
+	if len(i.Components) > 0 {
+		out.Components = unorderedStringList(i.Components)
+	}
+	return out
+}
+
+func incidentToIncidentRequest(data StatusPageIncidentModel) *cronitor.StatusPageIncident {
+	updates := []StatusPageIncidentUpdateModel{}
+	if !data.Updates.IsNull() && !data.Updates.IsUnknown() {
+		data.Updates.ElementsAs(context.Background(), &updates, false)
+	}
+
+	out := &cronitor.StatusPageIncident{
+		Title:      data.Title.ValueString(),
+		Status:     data.Status.ValueString(),
+		Components: toStringSlice(data.Components),
+		Updates:    []cronitor.StatusPageIncidentUpdate{},
+	}
+	for _, u := range updates {
+		out.Updates = append(out.Updates, cronitor.StatusPageIncidentUpdate{
+			Status:  u.Status.ValueString(),
+			Message: u.Message.ValueString(),
+		})
+	}
+	return out
+}
+
+type StatusPageSubscriberModel struct {
+	ID         types.String   `tfsdk:"id"`
+	StatusPage types.String   `tfsdk:"status_page"`
+	Type       types.String   `tfsdk:"type"`
+	Email      types.String   `tfsdk:"email"`
+	Url        types.String   `tfsdk:"url"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func toStatusPageSubscriber(s *cronitor.StatusPageSubscriber, prior StatusPageSubscriberModel) StatusPageSubscriberModel {
+	return StatusPageSubscriberModel{
+		ID:         types.StringValue(s.ID),
+		StatusPage: prior.StatusPage,
+		Type:       types.StringValue(s.Type),
+		Email:      optionalString(s.Email),
+		Url:        optionalString(s.Url),
+		Timeouts:   prior.Timeouts,
+	}
+}
+
+func subscriberToSubscriberRequest(data StatusPageSubscriberModel) *cronitor.StatusPageSubscriber {
+	return &cronitor.StatusPageSubscriber{
+		Type:  data.Type.ValueString(),
+		Email: data.Email.ValueString(),
+		Url:   data.Url.ValueString(),
+	}
+}
+
+type IssueModel struct {
+	Key         types.String       `tfsdk:"key"`
+	Name        types.String       `tfsdk:"name"`
+	Body        types.String       `tfsdk:"body"`
+	State       types.String       `tfsdk:"state"`
+	Severity    types.String       `tfsdk:"severity"`
+	Monitors    UnorderedListValue `tfsdk:"monitors"`
+	AutoResolve types.Bool         `tfsdk:"auto_resolve"`
+	Timeouts    timeouts.Value     `tfsdk:"timeouts"`
+}
+
+func toIssue(i *cronitor.Issue, prior IssueModel) IssueModel {
+	out := IssueModel{
+		Key:         types.StringValue(i.Key),
+		Name:        types.StringValue(i.Name),
+		Body:        optionalString(i.Body),
+		State:       types.StringValue(i.State),
+		Severity:    types.StringValue(i.Severity),
+		Monitors:    unorderedStringListNull(),
+		AutoResolve: types.BoolValue(i.AutoResolve),
+		Timeouts:    prior.Timeouts,
+	}
+	if len(i.Monitors) > 0 {
+		out.Monitors = unorderedStringList(i.Monitors)
+	}
+	return out
+}
+
+func issueToIssueRequest(data IssueModel) *cronitor.Issue {
+	return &cronitor.Issue{
+		Name:        data.Name.ValueString(),
+		Body:        data.Body.ValueString(),
+		State:       data.State.ValueString(),
+		Severity:    data.Severity.ValueString(),
+		Monitors:    toStringSlice(data.Monitors),
+		AutoResolve: data.AutoResolve.ValueBool(),
+	}
+}
+
+type AlertActionModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Monitor       types.String   `tfsdk:"monitor"`
+	Action        types.String   `tfsdk:"action"`
+	Note          types.String   `tfsdk:"note"`
+	IgnoreMissing types.Bool     `tfsdk:"ignore_missing"`
+	Triggers      types.Map      `tfsdk:"triggers"`
+	PerformedAt   types.String   `tfsdk:"performed_at"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+type AccountSettingsModel struct {
+	ID                      types.String       `tfsdk:"id"`
+	DefaultRealertInterval  types.String       `tfsdk:"default_realert_interval"`
+	DefaultNotificationList types.String       `tfsdk:"default_notification_list"`
+	WeeklyReportRecipients  UnorderedListValue `tfsdk:"weekly_report_recipients"`
+	Timeouts                timeouts.Value     `tfsdk:"timeouts"`
+}
+
+func toAccountSettings(s *cronitor.AccountSettings, prior AccountSettingsModel) AccountSettingsModel {
+	return AccountSettingsModel{
+		ID:                      types.StringValue(accountSettingsID),
+		DefaultRealertInterval:  types.StringValue(s.DefaultRealertInterval),
+		DefaultNotificationList: types.StringValue(s.DefaultNotificationList),
+		WeeklyReportRecipients:  unorderedStringList(s.WeeklyReportRecipients),
+		Timeouts:                prior.Timeouts,
 	}
+}
 
-	new := []T{}
-	new = append(new, correct...)
-	*incorrect = new
+// accountSettingsToRequest only sets the values that are configured, so the
+// rest are left as they are.
+func accountSettingsToRequest(data AccountSettingsModel) *cronitor.AccountSettings {
+	out := &cronitor.AccountSettings{
+		DefaultRealertInterval:  data.DefaultRealertInterval.ValueString(),
+		DefaultNotificationList: data.DefaultNotificationList.ValueString(),
+	}
+	if !data.WeeklyReportRecipients.IsNull() && !data.WeeklyReportRecipients.IsUnknown() {
+		out.WeeklyReportRecipients = toStringSlice(data.WeeklyReportRecipients)
+	}
+	return out
+}
+
+type WebhookSigningSecretModel struct {
+	ID               types.String   `tfsdk:"id"`
+	Secret           types.String   `tfsdk:"secret"`
+	RotationTriggers types.Map      `tfsdk:"rotation_triggers"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+func toWebhookSigningSecret(s *cronitor.WebhookSigningSecret, prior WebhookSigningSecretModel) WebhookSigningSecretModel {
+	return WebhookSigningSecretModel{
+		ID:               types.StringValue(webhookSigningSecretID),
+		Secret:           types.StringValue(s.Secret),
+		RotationTriggers: prior.RotationTriggers,
+		CreatedAt:        optionalString(s.CreatedAt),
+		Timeouts:         prior.Timeouts,
+	}
 }
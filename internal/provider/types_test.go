@@ -0,0 +1,79 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/henrywhitaker3/terraform-provider-cronitor/pkg/cronitor"
+)
+
+func TestOptionalInt32(t *testing.T) {
+	three := 3
+	tcs := []struct {
+		name     string
+		in       *int
+		prior    types.Int32
+		imported bool
+		want     types.Int32
+	}{
+		{name: "set", in: &three, prior: types.Int32Value(3), want: types.Int32Value(3)},
+		{name: "removed from config", in: &three, prior: types.Int32Null(), want: types.Int32Null()},
+		{name: "imported", in: &three, prior: types.Int32Null(), imported: true, want: types.Int32Value(3)},
+		{name: "cleared by the api", in: nil, prior: types.Int32Value(3), want: types.Int32Null()},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := optionalInt32(tc.in, tc.prior, tc.imported); !got.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSetInheritedNotify(t *testing.T) {
+	tcs := []struct {
+		name  string
+		prior UnorderedListValue
+		api   []string
+		want  UnorderedListValue
+	}{
+		{name: "not set", prior: unorderedStringListNull(), api: []string{"default"}, want: unorderedStringListNull()},
+		{name: "set", prior: unorderedStringList([]string{"ops"}), api: []string{"ops"}, want: unorderedStringList([]string{"ops"})},
+		{name: "changed in cronitor", prior: unorderedStringList([]string{"ops"}), api: []string{"ops", "devs"}, want: unorderedStringList([]string{"ops", "devs"})},
+		{name: "empty", prior: unorderedStringList([]string{}), api: []string{"default"}, want: unorderedStringList([]string{})},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			prior := BaseMonitorModel{Name: types.StringValue("test"), Notify: tc.prior}
+			var got BaseMonitorModel
+			got.setInherited(&cronitor.Monitor{Notify: tc.api}, prior)
+			if !got.Notify.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got.Notify)
+			}
+		})
+	}
+}
+
+func TestToHttpMonitorExpectedStatusCodes(t *testing.T) {
+	codes, _ := types.ListValueFrom(context.Background(), types.Int32Type, []int32{200, 204})
+	prior := HttpMonitorModel{ExpectedStatusCodes: codes}
+	key := "abc"
+	m := &cronitor.Monitor{
+		Key:        &key,
+		Type:       "check",
+		Assertions: []string{"response.code = 200", "response.time < 100ms", "response.code = 204"},
+		Request:    &cronitor.Request{},
+	}
+
+	got := toHttpMonitor(m, prior)
+	if !got.ExpectedStatusCodes.Equal(codes) {
+		t.Errorf("expected the status codes to be split out, got %s", got.ExpectedStatusCodes)
+	}
+	if want := unorderedStringList([]string{"response.time < 100ms"}); !got.Assertions.Equal(want) {
+		t.Errorf("expected %s to be left in the assertions, got %s", want, got.Assertions)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// When a schema changes in a way that existing state can't be read with, such
+// as an attribute moving into a block or changing type, its version is bumped
+// and the resource implements UpgradeState, with an upgrader from the previous
+// version keyed by that version. The previous schema and model are
+// kept alongside the upgrader so it keeps working as the current ones change.
+
+// stateUpgrader reads the prior state into the prior model, converts it and
+// saves it as the current state.
+func stateUpgrader[P, C any](prior schema.Schema, upgrade func(context.Context, P) (C, diag.Diagnostics)) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		PriorSchema: &prior,
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			var data P
+			resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			out, diags := upgrade(ctx, data)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+		},
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ValidateScheduleFunction{}
+
+func NewValidateScheduleFunction() function.Function {
+	return &ValidateScheduleFunction{}
+}
+
+// ValidateScheduleFunction checks whether a schedule is one cronitor accepts.
+type ValidateScheduleFunction struct{}
+
+func (f *ValidateScheduleFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_schedule"
+}
+
+func (f *ValidateScheduleFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Checks whether a monitor schedule is valid",
+		MarkdownDescription: "Returns whether the schedule is a valid interval, such as `every 5 minutes`, or a 5 field cron expression, such as `*/5 * * * *`. Set `strict` to return an error describing the problem instead of `false`",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "schedule",
+				MarkdownDescription: "The schedule to check",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:                "strict",
+			MarkdownDescription: "Whether to return an error for invalid schedules, only the first value is used",
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *ValidateScheduleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schedule string
+	var strict []bool
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &schedule, &strict))
+	if resp.Error != nil {
+		return
+	}
+
+	err := validSchedule(schedule)
+	if err != nil && len(strict) > 0 && strict[0] {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, err == nil))
+}
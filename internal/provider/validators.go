@@ -0,0 +1,269 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var realertIntervalRegex = regexp.MustCompile(`^every [1-9]\d* (minute|hour|day)s?$`)
+
+var _ resource.ConfigValidator = checkAssertionsValidator{}
+var _ resource.ConfigValidator = checkScheduleValidator{}
+var _ resource.ConfigValidator = heartbeatScheduleValidator{}
+var _ resource.ConfigValidator = requestBodyValidator{}
+var _ resource.ConfigValidator = bearerTokenValidator{}
+var _ resource.ConfigValidator = maxRedirectsValidator{}
+var _ resource.ConfigValidator = notificationChannelsValidator{}
+
+// checkAssertionsValidator ensures that http monitors have something to check
+// the response against.
+type checkAssertionsValidator struct{}
+
+func (v checkAssertionsValidator) Description(ctx context.Context) string {
+	return "at least one assertion must be set"
+}
+
+func (v checkAssertionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v checkAssertionsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data HttpMonitorModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Assertions.IsUnknown() || data.JsonAssertions.IsUnknown() || data.HeaderAssertions.IsUnknown() {
+		return
+	}
+	if len(data.Assertions.Elements()) > 0 || len(data.JsonAssertions.Elements()) > 0 || len(data.HeaderAssertions.Elements()) > 0 {
+		return
+	}
+	if !data.ExpectedStatusCode.IsNull() || !data.ExpectedStatusCodes.IsNull() || !data.MaxResponseTimeMs.IsNull() || !data.SslExpiresWithin.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("assertions"),
+		"missing assertions",
+		"http monitors need at least one of assertions, expected_status_code, expected_status_codes, max_response_time_ms, ssl_expires_within_days, json_assertion or header_assertion",
+	)
+}
+
+// checkScheduleValidator ensures that http monitors are scheduled to run on an
+// interval, as checks can't run on a cron schedule.
+type checkScheduleValidator struct{}
+
+func (v checkScheduleValidator) Description(ctx context.Context) string {
+	return "the schedule must be an interval"
+}
+
+func (v checkScheduleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v checkScheduleValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data HttpMonitorModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() && !intervalScheduleRegex.MatchString(data.Schedule.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("schedule"), "invalid schedule", "http monitors must use an interval schedule, such as `every 60 seconds`")
+	}
+	if spec, ok := toScheduleSpec(data.ScheduleSpec); ok && !spec.Type.IsUnknown() && spec.Type.ValueString() != scheduleTypeInterval {
+		resp.Diagnostics.AddAttributeError(path.Root("schedule_spec").AtName("type"), "invalid schedule", "http monitors must use an interval schedule")
+	}
+}
+
+// heartbeatScheduleValidator ensures that heartbeat monitors use a schedule the
+// api accepts, which can be either a cron expression or an interval.
+type heartbeatScheduleValidator struct{}
+
+func (v heartbeatScheduleValidator) Description(ctx context.Context) string {
+	return "the schedule must be a cron expression or an interval"
+}
+
+func (v heartbeatScheduleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v heartbeatScheduleValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data HeartbeatMonitorModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
+		schedule := data.Schedule.ValueString()
+		if err := validSchedule(schedule); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("schedule"),
+				"invalid schedule",
+				fmt.Sprintf("heartbeat monitors must use a cron expression, such as `0 * * * *`, or an interval, such as `every 5 minutes`: %s", err),
+			)
+		} else if data.ScheduleType.ValueString() == scheduleTypeCron && intervalScheduleRegex.MatchString(schedule) {
+			resp.Diagnostics.AddAttributeError(path.Root("schedule"), "invalid schedule", "schedule must be a cron expression when schedule_type is cron, use every_seconds for intervals")
+		}
+	}
+	if spec, ok := toScheduleSpec(data.ScheduleSpec); ok && spec.Type.ValueString() == scheduleTypeCron && !spec.Expression.IsNull() && !spec.Expression.IsUnknown() {
+		if _, err := parseCron(spec.Expression.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("schedule_spec").AtName("expression"), "invalid schedule", fmt.Sprintf("invalid cron expression: %s", err))
+		}
+	}
+}
+
+// requestBodyValidator ensures that http monitors only send a body with methods
+// that have one, as it is dropped from GET and HEAD requests.
+type requestBodyValidator struct{}
+
+func (v requestBodyValidator) Description(ctx context.Context) string {
+	return "body can't be set for GET or HEAD requests"
+}
+
+func (v requestBodyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v requestBodyValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data HttpMonitorModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, ok := toHttpRequest(data.Request)
+	if !ok || request.Body.IsNull() || request.Method.IsUnknown() {
+		return
+	}
+	if method := strings.ToUpper(request.Method.ValueString()); method == http.MethodGet || method == http.MethodHead {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request").AtName("body"),
+			"invalid request body",
+			fmt.Sprintf("body can't be sent with %s requests", method),
+		)
+	}
+}
+
+// bearerTokenValidator ensures that bearer_token isn't used alongside an
+// authorization header, as one would overwrite the other.
+type bearerTokenValidator struct{}
+
+func (v bearerTokenValidator) Description(ctx context.Context) string {
+	return "bearer_token conflicts with an authorization header"
+}
+
+func (v bearerTokenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v bearerTokenValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data HttpMonitorModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.BearerToken.IsNull() {
+		return
+	}
+	request, _ := toHttpRequest(data.Request)
+	_, inHeaders := toStringMap(request.Headers)[authorizationHeader]
+	_, inHeadersMulti := toStringListMap(data.HeadersMulti)[authorizationHeader]
+	if inHeaders || inHeadersMulti {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bearer_token"),
+			"conflicting authorization header",
+			"bearer_token cannot be used alongside an authorization header",
+		)
+	}
+}
+
+// maxRedirectsValidator ensures that max_redirects is only set when redirects
+// are followed.
+type maxRedirectsValidator struct{}
+
+func (v maxRedirectsValidator) Description(ctx context.Context) string {
+	return "max_redirects can't be set when follow_redirects is false"
+}
+
+func (v maxRedirectsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v maxRedirectsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data HttpMonitorModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, _ := toHttpRequest(data.Request)
+	if request.MaxRedirects.IsNull() || request.FollowRedirects.IsNull() || request.FollowRedirects.IsUnknown() {
+		return
+	}
+	if !request.FollowRedirects.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("request").AtName("max_redirects"), "redirects are disabled", "max_redirects cannot be set when follow_redirects is false")
+	}
+}
+
+// notificationChannelsValidator ensures that notification lists have at least
+// one channel, as the api accepts lists without any and their alerts are lost.
+type notificationChannelsValidator struct{}
+
+func (v notificationChannelsValidator) Description(ctx context.Context) string {
+	return "at least one notification channel must be set"
+}
+
+func (v notificationChannelsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v notificationChannelsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NotificationListResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, channel := range []types.List{data.Emails.ListValue, data.Slack.ListValue, data.Pagerduty.ListValue, data.Phones.ListValue, data.Webhooks.ListValue, data.Webhook, data.Sns.ListValue} {
+		if channel.IsUnknown() || len(channel.Elements()) > 0 {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"missing notification channels",
+		"notification lists need at least one of emails, slack, pagerduty, phones, webhooks, webhook or sns",
+	)
+}
+
+// validateRealertInterval checks the realert interval is in a form the api
+// accepts.
+func validateRealertInterval(in types.String, diags *diag.Diagnostics) {
+	if in.IsNull() || in.IsUnknown() {
+		return
+	}
+	interval := in.ValueString()
+	if !realertIntervalRegex.MatchString(interval) {
+		diags.AddAttributeError(
+			path.Root("realert_interval"),
+			"invalid realert_interval",
+			fmt.Sprintf("%q must be in the form `every <n> <minutes|hours|days>`, such as `every 8 hours`", interval),
+		)
+	}
+}
@@ -11,23 +11,67 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type Client struct {
-	endpoint string
-	ApiKey   string
-	client   *http.Client
+	endpoint     string
+	ApiKey       string
+	TelemetryKey string
+	client       *http.Client
+
+	// OfflineRefresh keeps the prior state of resources that can't be read
+	// because the api is unreachable
+	OfflineRefresh bool
 
 	listKeyRegex *regexp.Regexp
+	monitors     *monitorCache
+	reads        *readCache
+	deletes      *deleteBatcher
+	throttle     *throttledTransport
+
+	operationBudget time.Duration
+	runBudget       *retryBudget
+
+	monitorNames *nameIndex[Monitor]
+	listNames    *nameIndex[NotificationList]
 }
 
 type NewClientOpts struct {
-	Endpoint string
-	ApiKey   string
-	Client   *http.Client
+	Endpoint     string
+	ApiKey       string
+	TelemetryKey string
+	Client       *http.Client
+
+	// OperationRetryBudget is the most time the requests with a context from
+	// WithOperation spend waiting to be retried, and RunRetryBudget is the
+	// most all requests spend. Neither is limited when it is 0
+	OperationRetryBudget time.Duration
+	RunRetryBudget       time.Duration
+
+	// RetryMethods overrides whether requests with each method are retried
+	// when the api responds with a server error, on top of DefaultRetryMethods
+	RetryMethods map[string]bool
+
+	// OfflineRefresh sets Client.OfflineRefresh
+	OfflineRefresh bool
+
+	// BatchReads makes GetMonitorBatched read every monitor with one list call
+	BatchReads bool
+
+	// ReadCacheTTL is how long the Cached methods keep responses for, they
+	// aren't cached when it is 0
+	ReadCacheTTL time.Duration
+
+	// OnThrottle is called with the new gap between requests each time the
+	// api rate limits a request
+	OnThrottle func(ctx context.Context, interval time.Duration)
 }
 
 func NewClient(opts NewClientOpts) *Client {
@@ -37,16 +81,39 @@ func NewClient(opts NewClientOpts) *Client {
 	if opts.Client == nil {
 		opts.Client = http.DefaultClient
 	}
+	httpClient := *opts.Client
+	throttle := newThrottledTransport(httpClient.Transport, opts.OnThrottle)
+	retries := newRetryTransport(throttle, opts.RetryMethods)
+	httpClient.Transport = retries
 
 	// Ignore the error as it will always compile
 	regex, _ := regexp.Compile(`^[0-9a-z0-9-_]+$`)
 
-	return &Client{
+	c := &Client{
 		endpoint:     opts.Endpoint,
 		ApiKey:       opts.ApiKey,
-		client:       opts.Client,
+		TelemetryKey: opts.TelemetryKey,
+		client:       &httpClient,
 		listKeyRegex: regex,
+		deletes:      &deleteBatcher{pending: map[string][]pendingDelete{}},
+		throttle:     throttle,
+		monitorNames: &nameIndex[Monitor]{},
+		listNames:    &nameIndex[NotificationList]{},
+
+		operationBudget: opts.OperationRetryBudget,
+		runBudget:       &retryBudget{name: "per run", limit: opts.RunRetryBudget},
+
+		OfflineRefresh: opts.OfflineRefresh,
+	}
+	throttle.waitToRetry = c.WaitToRetry
+	retries.waitToRetry = c.WaitToRetry
+	if opts.BatchReads {
+		c.monitors = &monitorCache{monitors: map[string]Monitor{}}
 	}
+	if opts.ReadCacheTTL > 0 {
+		c.reads = newReadCache(opts.ReadCacheTTL)
+	}
+	return c
 }
 
 func (c *Client) GetMonitor(ctx context.Context, id string) (*Monitor, error) {
@@ -59,7 +126,11 @@ func (c *Client) GetMonitor(ctx context.Context, id string) (*Monitor, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %w: monitor %s", ErrFailedGetMonitor, ErrNotFound, id)
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("%w: url: %s, code %d", ErrFailedGetMonitor, req.URL.String(), resp.StatusCode)
 	}
@@ -77,7 +148,99 @@ func (c *Client) GetMonitor(ctx context.Context, id string) (*Monitor, error) {
 	return mon, nil
 }
 
+// ListMonitorsOptions filters the monitors that are listed.
+type ListMonitorsOptions struct {
+	// UpdatedSince only lists monitors changed after the time, when it is set
+	UpdatedSince time.Time
+}
+
+func (o ListMonitorsOptions) matches(m Monitor) bool {
+	if o.UpdatedSince.IsZero() || m.Updated == "" {
+		return true
+	}
+	updated, err := time.Parse(time.RFC3339, m.Updated)
+	return err != nil || updated.After(o.UpdatedSince)
+}
+
+// ListMonitors returns the monitors in the account matching the options,
+// fetching each page in turn.
+func (c *Client) ListMonitors(ctx context.Context, opts ListMonitorsOptions) ([]Monitor, error) {
+	out := []Monitor{}
+	for m, err := range c.MonitorsIter(ctx, opts) {
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
+
+// MonitorsIter iterates over the monitors in the account matching the options,
+// only fetching the next page once the monitors before it have been used, so
+// that large accounts don't need every monitor in memory at once. An error ends
+// the iteration.
+func (c *Client) MonitorsIter(ctx context.Context, opts ListMonitorsOptions) iter.Seq2[Monitor, error] {
+	return func(yield func(Monitor, error) bool) {
+		count := 0
+		for page := 1; ; page++ {
+			list, err := c.listMonitorsPage(ctx, page, opts)
+			if err != nil {
+				yield(Monitor{}, err)
+				return
+			}
+			count += len(list.Monitors)
+			for _, m := range list.Monitors {
+				// In case the api ignores the filter
+				if opts.matches(m) && !yield(m, nil) {
+					return
+				}
+			}
+			if len(list.Monitors) == 0 || (list.TotalCount > 0 && count >= list.TotalCount) {
+				return
+			}
+		}
+	}
+}
+
+func (c *Client) listMonitorsPage(ctx context.Context, page int, opts ListMonitorsOptions) (*MonitorList, error) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	if !opts.UpdatedSince.IsZero() {
+		query.Set("updated_since", opts.UpdatedSince.UTC().Format(time.RFC3339))
+	}
+	req, err := c.request(ctx, http.MethodGet, "/api/monitors?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build list request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list monitors: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedListMonitors, resp.StatusCode, string(body))
+	}
+
+	list := &MonitorList{}
+	if err := json.Unmarshal(body, list); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return list, nil
+}
+
+// CreateMonitor creates the monitor and returns the api response. Newly created
+// monitors aren't always readable straight away, so it isn't read back.
 func (c *Client) CreateMonitor(ctx context.Context, monitor *Monitor) (*Monitor, error) {
+	c.reads.forget(monitorsCacheKey)
+	c.monitorNames.forget()
 	c.setCreateDefaults(monitor)
 	req, err := c.request(ctx, http.MethodPost, "/api/monitors", monitor)
 	if err != nil {
@@ -88,12 +251,16 @@ func (c *Client) CreateMonitor(ctx context.Context, monitor *Monitor) (*Monitor,
 	if err != nil {
 		return nil, fmt.Errorf("failed to send create request: %w", err)
 	}
+	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to ready response body: %w", err)
 	}
 
+	if monitorExists(resp.StatusCode, body) {
+		return nil, fmt.Errorf("%w: %w: code %d response: %s", ErrFailedCreateMonitor, ErrMonitorExists, resp.StatusCode, string(body))
+	}
 	if resp.StatusCode != http.StatusCreated {
 		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedCreateMonitor, resp.StatusCode, string(body))
 	}
@@ -103,14 +270,43 @@ func (c *Client) CreateMonitor(ctx context.Context, monitor *Monitor) (*Monitor,
 		return nil, fmt.Errorf("failed to unmarshal json response: %w", err)
 	}
 
-	return c.GetMonitor(ctx, *mon.Key)
+	return mon, nil
+}
+
+// monitorExists reports whether a create was rejected because a monitor with
+// the same key already exists, which the api responds to with a conflict or a
+// bad request saying so.
+func monitorExists(code int, body []byte) bool {
+	if code == http.StatusConflict {
+		return true
+	}
+	return code == http.StatusBadRequest && strings.Contains(strings.ToLower(string(body)), "already exists")
 }
 
 func (c *Client) UpdateMonitor(ctx context.Context, monitor *Monitor) (*Monitor, error) {
 	if monitor.Key == nil {
 		return nil, errors.New("cannot update monitor with empty key")
 	}
-	req, err := c.request(ctx, http.MethodPut, fmt.Sprintf("/api/monitors/%s", *monitor.Key), monitor)
+	return c.updateMonitor(ctx, *monitor.Key, monitor)
+}
+
+// UpdateMonitorChanges updates only the fields of the monitor that differ from
+// prior, so fields the api manages that aren't in the monitor are left as they
+// are. Fields that are set in prior but not in the monitor are cleared.
+func (c *Client) UpdateMonitorChanges(ctx context.Context, prior, monitor *Monitor) (*Monitor, error) {
+	if monitor.Key == nil {
+		return nil, errors.New("cannot update monitor with empty key")
+	}
+	changes, err := monitorChanges(prior, monitor)
+	if err != nil {
+		return nil, err
+	}
+	return c.updateMonitor(ctx, *monitor.Key, changes)
+}
+
+func (c *Client) updateMonitor(ctx context.Context, key string, body any) (*Monitor, error) {
+	c.forgetMonitor(key)
+	req, err := c.request(ctx, http.MethodPut, fmt.Sprintf("/api/monitors/%s", key), body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build update request: %w", err)
 	}
@@ -119,20 +315,82 @@ func (c *Client) UpdateMonitor(ctx context.Context, monitor *Monitor) (*Monitor,
 	if err != nil {
 		return nil, fmt.Errorf("failed to update monitor: %w", err)
 	}
+	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to update monitor, code %d, response %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("failed to update monitor, code %d, response %s", resp.StatusCode, string(respBody))
+	}
+
+	return c.GetMonitor(ctx, key)
+}
+
+// monitorChanges returns the json fields of upd that differ from prior, with
+// null for those it no longer sets. The type is always included as the api
+// validates the other fields against it.
+func monitorChanges(prior, upd *Monitor) (map[string]json.RawMessage, error) {
+	fields := func(m *Monitor) (map[string]json.RawMessage, error) {
+		by, err := json.Marshal(m)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal monitor: %w", err)
+		}
+		out := map[string]json.RawMessage{}
+		return out, json.Unmarshal(by, &out)
+	}
+	before, err := fields(prior)
+	if err != nil {
+		return nil, err
+	}
+	after, err := fields(upd)
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]json.RawMessage{"type": after["type"]}
+	for k, v := range after {
+		if !bytes.Equal(before[k], v) {
+			out[k] = clearRemoved(before[k], v)
+		}
+	}
+	for k := range before {
+		if _, ok := after[k]; !ok {
+			out[k] = json.RawMessage("null")
+		}
 	}
+	return out, nil
+}
 
-	return c.GetMonitor(ctx, *monitor.Key)
+// clearRemoved adds null for the fields of the json object before that after no
+// longer sets, such as the body of a monitor's request, so they are cleared
+// rather than left out. Anything that isn't an object is returned as it is.
+func clearRemoved(before, after json.RawMessage) json.RawMessage {
+	var b, a map[string]json.RawMessage
+	if json.Unmarshal(before, &b) != nil || json.Unmarshal(after, &a) != nil || a == nil {
+		return after
+	}
+	cleared := false
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			a[k] = json.RawMessage("null")
+			cleared = true
+		}
+	}
+	if !cleared {
+		return after
+	}
+	out, err := json.Marshal(a)
+	if err != nil {
+		return after
+	}
+	return out
 }
 
 func (c *Client) DeleteMonitor(ctx context.Context, id string) error {
+	c.forgetMonitor(id)
 	req, err := c.request(ctx, http.MethodDelete, fmt.Sprintf("/api/monitors/%s", id), nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request to delete monitor %s: %w", id, err)
@@ -142,7 +400,11 @@ func (c *Client) DeleteMonitor(ctx context.Context, id string) error {
 	if err != nil {
 		return fmt.Errorf("failed to delete monitor: %w", err)
 	}
+	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %w: monitor %s", ErrFailedDeleteMonitor, ErrNotFound, id)
+	}
 	if resp.StatusCode > 299 {
 		return ErrFailedDeleteMonitor
 	}
@@ -150,6 +412,41 @@ func (c *Client) DeleteMonitor(ctx context.Context, id string) error {
 	return nil
 }
 
+// PauseMonitor pauses the monitor for the number of hours, or indefinitely when
+// hours is 0.
+func (c *Client) PauseMonitor(ctx context.Context, id string, hours int) error {
+	endpoint := fmt.Sprintf("/api/monitors/%s/pause", id)
+	if hours > 0 {
+		endpoint = fmt.Sprintf("%s/%d", endpoint, hours)
+	}
+	c.forgetMonitor(id)
+	return c.pause(ctx, endpoint)
+}
+
+func (c *Client) UnpauseMonitor(ctx context.Context, id string) error {
+	c.forgetMonitor(id)
+	return c.pause(ctx, fmt.Sprintf("/api/monitors/%s/pause/0", id))
+}
+
+func (c *Client) pause(ctx context.Context, endpoint string) error {
+	req, err := c.request(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build pause request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to change monitor pause state: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: url: %s, code %d", ErrFailedPauseMonitor, req.URL.String(), resp.StatusCode)
+	}
+
+	return nil
+}
+
 func (c *Client) GetNotificationList(ctx context.Context, id string) (*NotificationList, error) {
 	req, err := c.request(ctx, http.MethodGet, fmt.Sprintf("/v1/templates/%s", id), nil)
 	if err != nil {
@@ -160,12 +457,16 @@ func (c *Client) GetNotificationList(ctx context.Context, id string) (*Notificat
 	if err != nil {
 		return nil, fmt.Errorf("failed to get notification list: %w", err)
 	}
+	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("failed to get notification list %s: %w", id, ErrNotFound)
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("failed to get notification list code: %d body: %s", resp.StatusCode, string(body))
 	}
@@ -178,18 +479,69 @@ func (c *Client) GetNotificationList(ctx context.Context, id string) (*Notificat
 	return out, nil
 }
 
-func (c *Client) CreateNotificationList(ctx context.Context, list *NotificationList) (*NotificationList, error) {
-	key := make([]byte, 3)
-	_, err := rand.Read(key)
+// ListNotificationLists returns all of the notification lists in the account,
+// fetching each page in turn.
+func (c *Client) ListNotificationLists(ctx context.Context) ([]NotificationList, error) {
+	out := []NotificationList{}
+	for page := 1; ; page++ {
+		list, err := c.listNotificationListsPage(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, list.Templates...)
+		if len(list.Templates) == 0 || (list.TotalCount > 0 && len(out) >= list.TotalCount) {
+			break
+		}
+	}
+	return out, nil
+}
+
+func (c *Client) listNotificationListsPage(ctx context.Context, page int) (*NotificationListPage, error) {
+	req, err := c.request(ctx, http.MethodGet, fmt.Sprintf("/v1/templates?page=%d", page), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification lists: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create random bytes: %w", err)
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedListNotificationLists, resp.StatusCode, string(body))
 	}
 
-	list.Key = fmt.Sprintf("%s-%s", strings.ToLower(list.Name), hex.EncodeToString(key))
+	list := &NotificationListPage{}
+	if err := json.Unmarshal(body, list); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return list, nil
+}
+
+func (c *Client) CreateNotificationList(ctx context.Context, list *NotificationList) (*NotificationList, error) {
+	if list.Key == "" {
+		key := make([]byte, 3)
+		_, err := rand.Read(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create random bytes: %w", err)
+		}
+
+		list.Key = fmt.Sprintf("%s-%s", Slug(list.Name), hex.EncodeToString(key))
+	}
 	if !c.listKeyRegex.Match([]byte(list.Key)) {
 		return nil, fmt.Errorf("invalid key, only lowercase letters, numbers, dashes and underscores: %s", list.Key)
 	}
 
+	c.reads.forget(notificationListCacheKey(list.Key), notificationListsCacheKey)
+	c.listNames.forget()
+
 	req, err := c.request(ctx, http.MethodPost, "/v1/templates", list)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build request: %w", err)
@@ -199,6 +551,7 @@ func (c *Client) CreateNotificationList(ctx context.Context, list *NotificationL
 	if err != nil {
 		return nil, fmt.Errorf("failed to create notification list: %w", err)
 	}
+	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -213,6 +566,8 @@ func (c *Client) CreateNotificationList(ctx context.Context, list *NotificationL
 }
 
 func (c *Client) UpdateNotificationList(ctx context.Context, list *NotificationList) (*NotificationList, error) {
+	c.reads.forget(notificationListCacheKey(list.Key), notificationListsCacheKey)
+	c.listNames.forget()
 	req, err := c.request(ctx, http.MethodPut, fmt.Sprintf("/v1/templates/%s", list.Key), list)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build request: %w", err)
@@ -222,6 +577,7 @@ func (c *Client) UpdateNotificationList(ctx context.Context, list *NotificationL
 	if err != nil {
 		return nil, fmt.Errorf("failed to update notification list: %w", err)
 	}
+	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -236,6 +592,8 @@ func (c *Client) UpdateNotificationList(ctx context.Context, list *NotificationL
 }
 
 func (c *Client) DeleteNotificationList(ctx context.Context, list *NotificationList) error {
+	c.reads.forget(notificationListCacheKey(list.Key), notificationListsCacheKey)
+	c.listNames.forget()
 	req, err := c.request(ctx, http.MethodDelete, fmt.Sprintf("/v1/templates/%s", list.Key), list)
 	if err != nil {
 		return fmt.Errorf("failed to build request: %w", err)
@@ -245,7 +603,11 @@ func (c *Client) DeleteNotificationList(ctx context.Context, list *NotificationL
 	if err != nil {
 		return fmt.Errorf("failed to delete notification list: %w", err)
 	}
+	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("failed to delete notification list %s: %w", list.Key, ErrNotFound)
+	}
 	if resp.StatusCode != http.StatusNoContent {
 		return fmt.Errorf("failed to update notification list code: %d", resp.StatusCode)
 	}
@@ -253,16 +615,811 @@ func (c *Client) DeleteNotificationList(ctx context.Context, list *NotificationL
 	return nil
 }
 
-func (c *Client) setCreateDefaults(mon *Monitor) {
-	if mon.RealertInterval == "" {
-		mon.RealertInterval = "every 8 hours"
+// TelemetryUrl returns the url that pings for the monitor are sent to. The
+// telemetry key is used when one is set, the api key is never included as it
+// grants full access to the account.
+func (c *Client) TelemetryUrl(key string) string {
+	if c.TelemetryKey == "" {
+		return fmt.Sprintf("https://cronitor.link/%s", key)
+	}
+	return fmt.Sprintf("https://cronitor.link/p/%s/%s", c.TelemetryKey, key)
+}
+
+func (c *Client) GetGroup(ctx context.Context, key string) (*Group, error) {
+	req, err := c.request(ctx, http.MethodGet, fmt.Sprintf("/api/groups/%s", key), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %w: group %s", ErrFailedGetGroup, ErrNotFound, key)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedGetGroup, resp.StatusCode, string(body))
+	}
+
+	grp := &Group{}
+	if err := json.Unmarshal(body, grp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return grp, nil
+}
+
+func (c *Client) CreateGroup(ctx context.Context, group *Group) (*Group, error) {
+	req, err := c.request(ctx, http.MethodPost, "/api/groups", group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedCreateGroup, resp.StatusCode, string(body))
+	}
+
+	grp := &Group{}
+	if err := json.Unmarshal(body, grp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return grp, nil
+}
+
+func (c *Client) UpdateGroup(ctx context.Context, group *Group) (*Group, error) {
+	if group.Key == "" {
+		return nil, errors.New("cannot update group with empty key")
+	}
+	req, err := c.request(ctx, http.MethodPut, fmt.Sprintf("/api/groups/%s", group.Key), group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update group: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedUpdateGroup, resp.StatusCode, string(body))
+	}
+
+	return c.GetGroup(ctx, group.Key)
+}
+
+func (c *Client) DeleteGroup(ctx context.Context, key string) error {
+	req, err := c.request(ctx, http.MethodDelete, fmt.Sprintf("/api/groups/%s", key), nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete group: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %w: group %s", ErrFailedDeleteGroup, ErrNotFound, key)
+	}
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("%w: code %d", ErrFailedDeleteGroup, resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *Client) GetStatusPage(ctx context.Context, key string) (*StatusPage, error) {
+	req, err := c.request(ctx, http.MethodGet, fmt.Sprintf("/api/statuspages/%s", key), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status page %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %w: status page %s", ErrFailedGetStatusPage, ErrNotFound, key)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedGetStatusPage, resp.StatusCode, string(body))
 	}
-	if len(mon.Notify) == 0 {
-		mon.Notify = []string{"default"}
+
+	page := &StatusPage{}
+	if err := json.Unmarshal(body, page); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return page, nil
+}
+
+// ListStatusPages returns all of the status pages in the account, fetching each
+// page in turn.
+func (c *Client) ListStatusPages(ctx context.Context) ([]StatusPage, error) {
+	out := []StatusPage{}
+	for page := 1; ; page++ {
+		list, err := c.listStatusPagesPage(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, list.StatusPages...)
+		if len(list.StatusPages) == 0 || (list.TotalCount > 0 && len(out) >= list.TotalCount) {
+			break
+		}
 	}
-	if len(mon.Environments) == 0 {
-		mon.Environments = []string{"production"}
+	return out, nil
+}
+
+func (c *Client) listStatusPagesPage(ctx context.Context, page int) (*StatusPageList, error) {
+	req, err := c.request(ctx, http.MethodGet, fmt.Sprintf("/api/statuspages?page=%d", page), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
 	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list status pages: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedListStatusPages, resp.StatusCode, string(body))
+	}
+
+	list := &StatusPageList{}
+	if err := json.Unmarshal(body, list); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return list, nil
+}
+
+func (c *Client) CreateStatusPage(ctx context.Context, page *StatusPage) (*StatusPage, error) {
+	req, err := c.request(ctx, http.MethodPost, "/api/statuspages", page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create status page: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedCreateStatusPage, resp.StatusCode, string(body))
+	}
+
+	out := &StatusPage{}
+	if err := json.Unmarshal(body, out); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return out, nil
+}
+
+func (c *Client) UpdateStatusPage(ctx context.Context, page *StatusPage) (*StatusPage, error) {
+	if page.Key == "" {
+		return nil, errors.New("cannot update status page with empty key")
+	}
+	req, err := c.request(ctx, http.MethodPut, fmt.Sprintf("/api/statuspages/%s", page.Key), page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update status page: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedUpdateStatusPage, resp.StatusCode, string(body))
+	}
+
+	return c.GetStatusPage(ctx, page.Key)
+}
+
+func (c *Client) DeleteStatusPage(ctx context.Context, key string) error {
+	req, err := c.request(ctx, http.MethodDelete, fmt.Sprintf("/api/statuspages/%s", key), nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete status page: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %w: status page %s", ErrFailedDeleteStatusPage, ErrNotFound, key)
+	}
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("%w: code %d", ErrFailedDeleteStatusPage, resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *Client) GetStatusPageSubscriber(ctx context.Context, page, id string) (*StatusPageSubscriber, error) {
+	req, err := c.request(ctx, http.MethodGet, fmt.Sprintf("/api/statuspages/%s/subscribers/%s", page, id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscriber %s: %w", id, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %w: subscriber %s", ErrFailedGetSubscriber, ErrNotFound, id)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedGetSubscriber, resp.StatusCode, string(body))
+	}
+
+	sub := &StatusPageSubscriber{}
+	if err := json.Unmarshal(body, sub); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return sub, nil
+}
+
+func (c *Client) CreateStatusPageSubscriber(ctx context.Context, page string, sub *StatusPageSubscriber) (*StatusPageSubscriber, error) {
+	req, err := c.request(ctx, http.MethodPost, fmt.Sprintf("/api/statuspages/%s/subscribers", page), sub)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subscriber: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedCreateSubscriber, resp.StatusCode, string(body))
+	}
+
+	out := &StatusPageSubscriber{}
+	if err := json.Unmarshal(body, out); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return out, nil
+}
+
+func (c *Client) DeleteStatusPageSubscriber(ctx context.Context, page, id string) error {
+	req, err := c.request(ctx, http.MethodDelete, fmt.Sprintf("/api/statuspages/%s/subscribers/%s", page, id), nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete subscriber: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %w: subscriber %s", ErrFailedDeleteSubscriber, ErrNotFound, id)
+	}
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("%w: code %d", ErrFailedDeleteSubscriber, resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *Client) GetStatusPageIncident(ctx context.Context, page, id string) (*StatusPageIncident, error) {
+	req, err := c.request(ctx, http.MethodGet, fmt.Sprintf("/api/statuspages/%s/incidents/%s", page, id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get incident %s: %w", id, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %w: incident %s", ErrFailedGetIncident, ErrNotFound, id)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedGetIncident, resp.StatusCode, string(body))
+	}
+
+	inc := &StatusPageIncident{}
+	if err := json.Unmarshal(body, inc); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return inc, nil
+}
+
+func (c *Client) CreateStatusPageIncident(ctx context.Context, page string, incident *StatusPageIncident) (*StatusPageIncident, error) {
+	req, err := c.request(ctx, http.MethodPost, fmt.Sprintf("/api/statuspages/%s/incidents", page), incident)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create incident: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedCreateIncident, resp.StatusCode, string(body))
+	}
+
+	out := &StatusPageIncident{}
+	if err := json.Unmarshal(body, out); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return out, nil
+}
+
+func (c *Client) UpdateStatusPageIncident(ctx context.Context, page string, incident *StatusPageIncident) (*StatusPageIncident, error) {
+	if incident.ID == "" {
+		return nil, errors.New("cannot update incident with empty id")
+	}
+	req, err := c.request(ctx, http.MethodPut, fmt.Sprintf("/api/statuspages/%s/incidents/%s", page, incident.ID), incident)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update incident: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedUpdateIncident, resp.StatusCode, string(body))
+	}
+
+	return c.GetStatusPageIncident(ctx, page, incident.ID)
+}
+
+func (c *Client) DeleteStatusPageIncident(ctx context.Context, page, id string) error {
+	req, err := c.request(ctx, http.MethodDelete, fmt.Sprintf("/api/statuspages/%s/incidents/%s", page, id), nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete incident: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %w: incident %s", ErrFailedDeleteIncident, ErrNotFound, id)
+	}
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("%w: code %d", ErrFailedDeleteIncident, resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *Client) GetIssue(ctx context.Context, key string) (*Issue, error) {
+	req, err := c.request(ctx, http.MethodGet, fmt.Sprintf("/api/issues/%s", key), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %w: issue %s", ErrFailedGetIssue, ErrNotFound, key)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedGetIssue, resp.StatusCode, string(body))
+	}
+
+	iss := &Issue{}
+	if err := json.Unmarshal(body, iss); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return iss, nil
+}
+
+func (c *Client) CreateIssue(ctx context.Context, issue *Issue) (*Issue, error) {
+	req, err := c.request(ctx, http.MethodPost, "/api/issues", issue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedCreateIssue, resp.StatusCode, string(body))
+	}
+
+	iss := &Issue{}
+	if err := json.Unmarshal(body, iss); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return iss, nil
+}
+
+func (c *Client) UpdateIssue(ctx context.Context, issue *Issue) (*Issue, error) {
+	if issue.Key == "" {
+		return nil, errors.New("cannot update issue with empty key")
+	}
+	req, err := c.request(ctx, http.MethodPut, fmt.Sprintf("/api/issues/%s", issue.Key), issue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update issue: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedUpdateIssue, resp.StatusCode, string(body))
+	}
+
+	return c.GetIssue(ctx, issue.Key)
+}
+
+func (c *Client) DeleteIssue(ctx context.Context, key string) error {
+	req, err := c.request(ctx, http.MethodDelete, fmt.Sprintf("/api/issues/%s", key), nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete issue: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %w: issue %s", ErrFailedDeleteIssue, ErrNotFound, key)
+	}
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("%w: code %d", ErrFailedDeleteIssue, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// AcknowledgeAlert acknowledges the open alert for the monitor, which stops it
+// from realerting until the monitor recovers.
+func (c *Client) AcknowledgeAlert(ctx context.Context, key, note string) error {
+	return c.alertAction(ctx, key, "acknowledge", note, ErrFailedAcknowledgeAlert)
+}
+
+// ResolveAlert resolves the open alert for the monitor.
+func (c *Client) ResolveAlert(ctx context.Context, key, note string) error {
+	return c.alertAction(ctx, key, "resolve", note, ErrFailedResolveAlert)
+}
+
+func (c *Client) alertAction(ctx context.Context, key, action, note string, failed error) error {
+	req, err := c.request(ctx, http.MethodPost, fmt.Sprintf("/api/monitors/%s/alerts/%s", key, action), alertActionRequest{Note: note})
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", failed, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %w: no open alert for monitor %s", failed, ErrNotFound, key)
+	}
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("%w: code %d response: %s", failed, resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// SendTestAlert sends a test alert to every channel of the notification list.
+// The alert is about the monitor when one is given.
+func (c *Client) SendTestAlert(ctx context.Context, list, monitor string) error {
+	req, err := c.request(ctx, http.MethodPost, fmt.Sprintf("/v1/templates/%s/test", list), testAlertRequest{Monitor: monitor})
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedSendTestAlert, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %w: notification list %s", ErrFailedSendTestAlert, ErrNotFound, list)
+	}
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("%w: code %d response: %s", ErrFailedSendTestAlert, resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+func (c *Client) GetAccountSettings(ctx context.Context) (*AccountSettings, error) {
+	req, err := c.request(ctx, http.MethodGet, "/api/settings", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account settings: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedGetAccountSettings, resp.StatusCode, string(body))
+	}
+
+	settings := &AccountSettings{}
+	if err := json.Unmarshal(body, settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return settings, nil
+}
+
+func (c *Client) UpdateAccountSettings(ctx context.Context, settings *AccountSettings) (*AccountSettings, error) {
+	req, err := c.request(ctx, http.MethodPut, "/api/settings", settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update account settings: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedUpdateAccountSettings, resp.StatusCode, string(body))
+	}
+
+	return c.GetAccountSettings(ctx)
+}
+
+func (c *Client) GetWebhookSigningSecret(ctx context.Context) (*WebhookSigningSecret, error) {
+	req, err := c.request(ctx, http.MethodGet, "/api/settings/webhook_secret", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook signing secret: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %w: webhook signing secret", ErrFailedGetWebhookSecret, ErrNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedGetWebhookSecret, resp.StatusCode, string(body))
+	}
+
+	secret := &WebhookSigningSecret{}
+	if err := json.Unmarshal(body, secret); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return secret, nil
+}
+
+// SetWebhookSigningSecret replaces the webhook signing secret, a new secret is
+// generated when it is empty.
+func (c *Client) SetWebhookSigningSecret(ctx context.Context, secret string) (*WebhookSigningSecret, error) {
+	req, err := c.request(ctx, http.MethodPost, "/api/settings/webhook_secret", WebhookSigningSecret{Secret: secret})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set webhook signing secret: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%w: code %d response: %s", ErrFailedSetWebhookSecret, resp.StatusCode, string(body))
+	}
+
+	out := &WebhookSigningSecret{}
+	if err := json.Unmarshal(body, out); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return out, nil
+}
+
+// Ping sends a telemetry event for the monitor, along with any metrics.
+func (c *Client) Ping(ctx context.Context, key string, opts PingOptions) error {
+	query := url.Values{}
+	for name, v := range map[string]string{"state": opts.State, "env": opts.Env, "message": opts.Message, "series": opts.Series} {
+		if v != "" {
+			query.Set(name, v)
+		}
+	}
+	if opts.Metrics.Count != nil {
+		query.Add("metric", fmt.Sprintf("count:%d", *opts.Metrics.Count))
+	}
+	if opts.Metrics.Duration != nil {
+		query.Add("metric", fmt.Sprintf("duration:%s", strconv.FormatFloat(*opts.Metrics.Duration, 'f', -1, 64)))
+	}
+	if opts.Metrics.ErrorCount != nil {
+		query.Add("metric", fmt.Sprintf("error_count:%d", *opts.Metrics.ErrorCount))
+	}
+
+	endpoint := c.TelemetryUrl(key)
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build ping request: %w", err)
+	}
+	req.Header.Set(RequestIDHeader, newRequestID(ctx))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedPing, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("%w: monitor %s, code %d", ErrFailedPing, key, resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *Client) setCreateDefaults(mon *Monitor) {
 	if mon.Request != nil {
 		if mon.Request.TimeoutSeconds == 0 {
 			mon.Request.TimeoutSeconds = 5
@@ -279,15 +1436,15 @@ func (c *Client) request(ctx context.Context, method, endpoint string, body any)
 		}
 		br = bytes.NewReader(by)
 	}
-	req, err := http.NewRequest(method, fmt.Sprintf("%s%s", c.endpoint, endpoint), br)
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s%s", c.endpoint, endpoint), br)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new request: %w", err)
 	}
 
-	req = req.WithContext(ctx)
 	req.SetBasicAuth(c.ApiKey, "")
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
+	req.Header.Set(RequestIDHeader, newRequestID(ctx))
 
 	return req, nil
 }
@@ -0,0 +1,184 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestClient returns a client for a test server that responds with handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts NewClientOpts) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	opts.Endpoint = srv.URL
+	opts.ApiKey = "test"
+	return NewClient(opts)
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestUpdateMonitorChangesClearsRemovedFields(t *testing.T) {
+	var sent map[string]json.RawMessage
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &sent); err != nil {
+				t.Errorf("failed to unmarshal update body: %s", err)
+			}
+		}
+		_, _ = w.Write([]byte(`{"key":"abc","type":"heartbeat"}`))
+	}, NewClientOpts{})
+
+	key := "abc"
+	prior := &Monitor{
+		Key:               &key,
+		Type:              "heartbeat",
+		Name:              "test",
+		FailureTolerance:  intPtr(3),
+		GraceSeconds:      intPtr(60),
+		ScheduleTolerance: intPtr(2),
+	}
+	upd := &Monitor{Key: &key, Type: "heartbeat", Name: "test"}
+
+	if _, err := c.UpdateMonitorChanges(context.Background(), prior, upd); err != nil {
+		t.Fatalf("failed to update monitor: %s", err)
+	}
+
+	for _, field := range []string{"failure_tolerance", "grace_seconds", "schedule_tolerance"} {
+		if string(sent[field]) != "null" {
+			t.Errorf("expected %s to be cleared with null, got %q", field, sent[field])
+		}
+	}
+	if _, ok := sent["name"]; ok {
+		t.Errorf("expected the unchanged name not to be sent")
+	}
+}
+
+func TestCreateMonitorLeavesUnsetFieldsOut(t *testing.T) {
+	var sent map[string]json.RawMessage
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &sent); err != nil {
+			t.Errorf("failed to unmarshal create body: %s", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"key":"abc","type":"heartbeat"}`))
+	}, NewClientOpts{})
+
+	if _, err := c.CreateMonitor(context.Background(), &Monitor{Type: "heartbeat", Name: "test"}); err != nil {
+		t.Fatalf("failed to create monitor: %s", err)
+	}
+
+	// Left out so cronitor uses the group's defaults, or its own
+	for _, field := range []string{"notify", "tags", "realert_interval", "environments"} {
+		if v, ok := sent[field]; ok {
+			t.Errorf("expected %s to be left out, got %s", field, v)
+		}
+	}
+}
+
+func TestSendTestAlert(t *testing.T) {
+	var method, path string
+	var sent testAlertRequest
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &sent); err != nil {
+			t.Errorf("failed to unmarshal test alert body: %s", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}, NewClientOpts{})
+
+	if err := c.SendTestAlert(context.Background(), "ops", "abc"); err != nil {
+		t.Fatalf("failed to send test alert: %s", err)
+	}
+	if method != http.MethodPost || path != "/v1/templates/ops/test" {
+		t.Errorf("expected POST /v1/templates/ops/test, got %s %s", method, path)
+	}
+	if sent.Monitor != "abc" {
+		t.Errorf("expected the test alert for monitor abc, got %q", sent.Monitor)
+	}
+}
+
+func TestCreateMonitorExists(t *testing.T) {
+	tcs := []struct {
+		name   string
+		code   int
+		body   string
+		exists bool
+	}{
+		{name: "conflict", code: http.StatusConflict, body: `{}`, exists: true},
+		{name: "bad request saying so", code: http.StatusBadRequest, body: `{"key":["Monitor with this key already exists."]}`, exists: true},
+		{name: "validation error", code: http.StatusBadRequest, body: `{"schedule":["Invalid schedule."]}`},
+		{name: "server error", code: http.StatusInternalServerError, body: `{}`},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.code)
+				_, _ = w.Write([]byte(tc.body))
+			}, NewClientOpts{})
+
+			_, err := c.CreateMonitor(context.Background(), &Monitor{Type: "heartbeat", Name: "test"})
+			if !errors.Is(err, ErrFailedCreateMonitor) {
+				t.Fatalf("expected the create to fail, got %v", err)
+			}
+			if got := errors.Is(err, ErrMonitorExists); got != tc.exists {
+				t.Errorf("expected the monitor to exist to be %t, got %t: %s", tc.exists, got, err)
+			}
+		})
+	}
+}
+
+func TestUpdateMonitorChangesClearsRemovedRequestFields(t *testing.T) {
+	var sent struct {
+		Request map[string]json.RawMessage `json:"request"`
+	}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &sent); err != nil {
+				t.Errorf("failed to unmarshal update body: %s", err)
+			}
+		}
+		_, _ = w.Write([]byte(`{"key":"abc","type":"check"}`))
+	}, NewClientOpts{})
+
+	key := "abc"
+	prior := &Monitor{
+		Key:  &key,
+		Type: "check",
+		Request: &Request{
+			URL:          "https://example.com",
+			Method:       http.MethodPost,
+			Body:         "a=1",
+			Headers:      map[string]string{"X-Test": "yes"},
+			MaxRedirects: intPtr(3),
+			ClientCert:   "cert",
+		},
+	}
+	upd := &Monitor{Key: &key, Type: "check", Request: &Request{URL: "https://example.com", Method: http.MethodPost}}
+
+	if _, err := c.UpdateMonitorChanges(context.Background(), prior, upd); err != nil {
+		t.Fatalf("failed to update monitor: %s", err)
+	}
+
+	for _, field := range []string{"body", "headers", "max_redirects", "client_certificate"} {
+		if string(sent.Request[field]) != "null" {
+			t.Errorf("expected request.%s to be cleared with null, got %q", field, sent.Request[field])
+		}
+	}
+	if string(sent.Request["url"]) != `"https://example.com"` {
+		t.Errorf("expected the rest of the request to be sent, got %s", sent.Request["url"])
+	}
+}
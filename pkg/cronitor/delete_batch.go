@@ -0,0 +1,168 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// deleteBatchWindow is how long deletes are collected for before they are sent
+// together.
+const deleteBatchWindow = 100 * time.Millisecond
+
+// deleteFallbackWorkers is how many monitors are deleted at once when a batch
+// falls back to deleting each monitor on its own.
+const deleteFallbackWorkers = 8
+
+// deleteBatcher collects the monitors being deleted at the same time, such as
+// during a destroy, so they can be deleted with one request.
+type deleteBatcher struct {
+	mu      sync.Mutex
+	pending map[string][]pendingDelete
+}
+
+// pendingDelete is a caller waiting for a monitor to be deleted. The ids of the
+// requests made for it are recorded against the caller's operation.
+type pendingDelete struct {
+	ctx  context.Context
+	done chan error
+}
+
+type deleteMonitorsRequest struct {
+	Monitors []string `json:"monitors"`
+}
+
+// DeleteMonitorBatched deletes the monitor along with any others being deleted
+// at the same time. When the batch can't be deleted together, each monitor in
+// it is deleted on its own so the errors are the same as DeleteMonitor's.
+func (c *Client) DeleteMonitorBatched(ctx context.Context, id string) error {
+	done := make(chan error, 1)
+
+	c.deletes.mu.Lock()
+	if len(c.deletes.pending) == 0 {
+		// The batch carries on when the request that started it is cancelled
+		batchCtx := context.WithoutCancel(ctx)
+		time.AfterFunc(deleteBatchWindow, func() { c.flushDeletes(batchCtx) })
+	}
+	c.deletes.pending[id] = append(c.deletes.pending[id], pendingDelete{ctx: ctx, done: done})
+	c.deletes.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-done:
+		return err
+	}
+}
+
+func (c *Client) flushDeletes(ctx context.Context) {
+	c.deletes.mu.Lock()
+	pending := c.deletes.pending
+	c.deletes.pending = map[string][]pendingDelete{}
+	c.deletes.mu.Unlock()
+
+	keys := make([]string, 0, len(pending))
+	for k := range pending {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var err error
+	if len(keys) > 1 {
+		bulkCtx := withRequestIDs(ctx)
+		err = c.deleteMonitors(bulkCtx, keys)
+		ids := RequestIDs(bulkCtx)
+		for _, waiting := range pending {
+			for _, p := range waiting {
+				recordRequestIDs(p.ctx, ids...)
+			}
+		}
+	}
+
+	results := make([]error, len(keys))
+	if len(keys) == 1 || err != nil {
+		results = c.deleteEach(keys, pending)
+	}
+
+	for i, k := range keys {
+		for _, p := range pending[k] {
+			p.done <- results[i]
+		}
+	}
+}
+
+// deleteEach deletes each monitor with its own request, a few at a time so large
+// batches don't take long or trip the rate limit. Each request is made with the
+// context of the first caller waiting on the monitor. The errors are in the
+// same order as the keys.
+func (c *Client) deleteEach(keys []string, pending map[string][]pendingDelete) []error {
+	results := make([]error, len(keys))
+	next := make(chan int)
+
+	var wg sync.WaitGroup
+	for range min(deleteFallbackWorkers, len(keys)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				results[i] = c.deleteFor(keys[i], pending[keys[i]])
+			}
+		}()
+	}
+	for i := range keys {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	return results
+}
+
+// deleteFor deletes the monitor for the callers waiting on it, recording the
+// ids of the requests against each of their operations.
+func (c *Client) deleteFor(key string, waiting []pendingDelete) error {
+	// The delete carries on when the caller is cancelled, like the batch does
+	ctx := withRequestIDs(context.WithoutCancel(waiting[0].ctx))
+	err := c.DeleteMonitor(ctx, key)
+	ids := RequestIDs(ctx)
+	for _, p := range waiting {
+		recordRequestIDs(p.ctx, ids...)
+	}
+	return err
+}
+
+// deleteMonitors deletes the monitors with a single request.
+func (c *Client) deleteMonitors(ctx context.Context, ids []string) error {
+	for _, id := range ids {
+		c.forgetMonitor(id)
+	}
+
+	req, err := c.request(ctx, http.MethodDelete, "/api/monitors", deleteMonitorsRequest{Monitors: ids})
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete monitors: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("%w: code %d response: %s", ErrFailedDeleteMonitor, resp.StatusCode, string(body))
+	}
+
+	return nil
+}
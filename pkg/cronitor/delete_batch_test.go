@@ -0,0 +1,77 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestDeleteMonitorBatchedRecordsRequestIDs(t *testing.T) {
+	tcs := []struct {
+		name     string
+		bulkCode int
+		// The paths of the requests each caller should have the ids of
+		want map[string][]string
+	}{
+		{
+			name:     "deleted together",
+			bulkCode: http.StatusOK,
+			want: map[string][]string{
+				"a": {"/api/monitors"},
+				"b": {"/api/monitors"},
+			},
+		},
+		{
+			name:     "deleted on their own",
+			bulkCode: http.StatusBadRequest,
+			want: map[string][]string{
+				"a": {"/api/monitors", "/api/monitors/a"},
+				"b": {"/api/monitors", "/api/monitors/b"},
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			paths := map[string]string{}
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				paths[r.Header.Get(RequestIDHeader)] = r.URL.Path
+				mu.Unlock()
+				if r.URL.Path == "/api/monitors" {
+					w.WriteHeader(tc.bulkCode)
+				}
+			}, NewClientOpts{})
+
+			ctxs := map[string]context.Context{}
+			var wg sync.WaitGroup
+			for key := range tc.want {
+				ctx := c.WithOperation(context.Background())
+				ctxs[key] = ctx
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if err := c.DeleteMonitorBatched(ctx, key); err != nil {
+						t.Errorf("failed to delete monitor %s: %s", key, err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			for key, want := range tc.want {
+				var got []string
+				for _, id := range RequestIDs(ctxs[key]) {
+					got = append(got, paths[id])
+				}
+				if !slices.Equal(got, want) {
+					t.Errorf("expected %s to have the ids of the requests to %v, got %v", key, want, got)
+				}
+			}
+		})
+	}
+}
@@ -2,10 +2,73 @@
 
 package cronitor
 
-import "errors"
+import (
+	"context"
+	"errors"
+	"net/url"
+)
 
 var (
 	ErrFailedGetMonitor    = errors.New("failed to get monitor details")
 	ErrFailedCreateMonitor = errors.New("failed to create monitor")
 	ErrFailedDeleteMonitor = errors.New("failed to delete monitor")
+	ErrFailedPauseMonitor  = errors.New("failed to pause monitor")
+	ErrFailedListMonitors  = errors.New("failed to list monitors")
+	ErrMonitorNotFound     = errors.New("monitor not found")
+	ErrMonitorExists       = errors.New("monitor already exists")
+	ErrNotFound            = errors.New("resource does not exist")
+
+	ErrRetryBudgetExhausted = errors.New("retry budget exhausted")
+
+	ErrFailedListNotificationLists = errors.New("failed to list notification lists")
+
+	ErrFailedGetGroup    = errors.New("failed to get group")
+	ErrFailedCreateGroup = errors.New("failed to create group")
+	ErrFailedUpdateGroup = errors.New("failed to update group")
+	ErrFailedDeleteGroup = errors.New("failed to delete group")
+
+	ErrFailedGetStatusPage    = errors.New("failed to get status page")
+	ErrFailedCreateStatusPage = errors.New("failed to create status page")
+	ErrFailedUpdateStatusPage = errors.New("failed to update status page")
+	ErrFailedDeleteStatusPage = errors.New("failed to delete status page")
+	ErrFailedListStatusPages  = errors.New("failed to list status pages")
+
+	ErrFailedGetSubscriber    = errors.New("failed to get status page subscriber")
+	ErrFailedCreateSubscriber = errors.New("failed to create status page subscriber")
+	ErrFailedDeleteSubscriber = errors.New("failed to delete status page subscriber")
+
+	ErrFailedGetIncident    = errors.New("failed to get status page incident")
+	ErrFailedCreateIncident = errors.New("failed to create status page incident")
+	ErrFailedUpdateIncident = errors.New("failed to update status page incident")
+	ErrFailedDeleteIncident = errors.New("failed to delete status page incident")
+
+	ErrFailedGetIssue    = errors.New("failed to get issue")
+	ErrFailedCreateIssue = errors.New("failed to create issue")
+	ErrFailedUpdateIssue = errors.New("failed to update issue")
+	ErrFailedDeleteIssue = errors.New("failed to delete issue")
+
+	ErrFailedAcknowledgeAlert = errors.New("failed to acknowledge alert")
+	ErrFailedResolveAlert     = errors.New("failed to resolve alert")
+	ErrFailedSendTestAlert    = errors.New("failed to send test alert")
+
+	ErrFailedPing = errors.New("failed to send ping")
+
+	ErrFailedGetAccountSettings    = errors.New("failed to get account settings")
+	ErrFailedUpdateAccountSettings = errors.New("failed to update account settings")
+
+	ErrFailedGetWebhookSecret = errors.New("failed to get webhook signing secret")
+	ErrFailedSetWebhookSecret = errors.New("failed to set webhook signing secret")
 )
+
+// IsUnreachable reports whether the request failed because the api couldn't be
+// reached, rather than the api responding with an error. Requests that were
+// cancelled, timed out or ran out of retry budget don't count.
+func IsUnreachable(err error) bool {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return false
+	}
+	return !errors.Is(err, context.Canceled) &&
+		!errors.Is(err, context.DeadlineExceeded) &&
+		!errors.Is(err, ErrRetryBudgetExhausted)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIsUnreachable(t *testing.T) {
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	throttled := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}
+	ok := func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"key":"abc"}`))
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+
+	tcs := []struct {
+		name   string
+		client func(t *testing.T) *Client
+		ctx    context.Context
+		want   bool
+	}{
+		{
+			name: "dial error",
+			client: func(t *testing.T) *Client {
+				return NewClient(NewClientOpts{Endpoint: closed.URL, ApiKey: "test"})
+			},
+			ctx:  context.Background(),
+			want: true,
+		},
+		{
+			name: "cancelled",
+			client: func(t *testing.T) *Client {
+				return newTestClient(t, ok, NewClientOpts{})
+			},
+			ctx:  cancelled,
+			want: false,
+		},
+		{
+			name: "timed out",
+			client: func(t *testing.T) *Client {
+				return newTestClient(t, ok, NewClientOpts{})
+			},
+			ctx:  expired,
+			want: false,
+		},
+		{
+			name: "retry budget exhausted",
+			client: func(t *testing.T) *Client {
+				return newTestClient(t, throttled, NewClientOpts{RunRetryBudget: time.Millisecond})
+			},
+			ctx:  context.Background(),
+			want: false,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.client(t).GetMonitor(tc.ctx, "abc")
+			if err == nil {
+				t.Fatal("expected the request to fail")
+			}
+			if got := IsUnreachable(err); got != tc.want {
+				t.Errorf("expected IsUnreachable to be %t for %q", tc.want, err)
+			}
+		})
+	}
+
+	if IsUnreachable(ErrNotFound) {
+		t.Error("expected an api error not to be unreachable")
+	}
+}
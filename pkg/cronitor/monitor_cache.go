@@ -0,0 +1,61 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"context"
+	"sync"
+)
+
+// monitorCache holds every monitor in the account from a single list call, so
+// refreshing a lot of monitors doesn't need a request for each of them.
+type monitorCache struct {
+	mu       sync.Mutex
+	primed   bool
+	monitors map[string]Monitor
+}
+
+// GetMonitorBatched gets the monitor from the list of all monitors, which is
+// fetched the first time it is called. Monitors that aren't in the list, such
+// as those created since, are fetched individually. It is the same as
+// GetMonitor when batched reads aren't enabled. The monitor is a copy, so it can
+// be changed without changing the cached one.
+func (c *Client) GetMonitorBatched(ctx context.Context, id string) (*Monitor, error) {
+	if c.monitors == nil {
+		return c.GetMonitor(ctx, id)
+	}
+
+	c.monitors.mu.Lock()
+	if !c.monitors.primed {
+		// Only try once, falling back to individual requests when it fails
+		c.monitors.primed = true
+		for m, err := range c.MonitorsIter(ctx, ListMonitorsOptions{}) {
+			if err != nil {
+				break
+			}
+			if m.Key != nil {
+				c.monitors.monitors[*m.Key] = m
+			}
+		}
+	}
+	mon, ok := c.monitors.monitors[id]
+	c.monitors.mu.Unlock()
+
+	if !ok {
+		return c.GetMonitor(ctx, id)
+	}
+	return mon.clone(), nil
+}
+
+// forgetMonitor removes the monitor from the cache after it has changed.
+func (c *Client) forgetMonitor(id string) {
+	c.reads.forget(monitorsCacheKey)
+	c.monitorNames.forget()
+	if c.monitors == nil {
+		return
+	}
+	c.monitors.mu.Lock()
+	defer c.monitors.mu.Unlock()
+	delete(c.monitors.monitors, id)
+}
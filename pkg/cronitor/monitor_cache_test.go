@@ -0,0 +1,35 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestGetMonitorBatchedReturnsCopies(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"monitors":[{"key":"abc","type":"check","assertions":["response.code = 200","response.time < 1s"],"request":{"url":"https://example.com","headers":{"Authorization":"Bearer abc"}}}],"page":1,"page_size":50,"total_monitor_count":1}`))
+	}, NewClientOpts{BatchReads: true})
+
+	first, err := c.GetMonitorBatched(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("failed to get monitor: %s", err)
+	}
+	first.Assertions = slices.Delete(first.Assertions, 0, 1)
+	delete(first.Request.Headers, "Authorization")
+
+	second, err := c.GetMonitorBatched(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("failed to get monitor: %s", err)
+	}
+	if want := []string{"response.code = 200", "response.time < 1s"}; !slices.Equal(second.Assertions, want) {
+		t.Errorf("expected the cached assertions to be %v, got %v", want, second.Assertions)
+	}
+	if second.Request.Headers["Authorization"] != "Bearer abc" {
+		t.Errorf("expected the cached headers to be kept, got %v", second.Request.Headers)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// nameIndex finds things by name from a single list of all of them, fetched the
+// first time it is used, so looking up many things by name doesn't list the
+// account each time. It is rebuilt after anything it holds has changed.
+type nameIndex[T any] struct {
+	mu     sync.Mutex
+	byName map[string][]T
+}
+
+// lookup returns everything with the name, building the index with list when
+// there isn't one. Errors aren't kept, so the next lookup tries again.
+func (i *nameIndex[T]) lookup(name string, list func() ([]T, error), nameOf func(T) string) ([]T, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.byName == nil {
+		all, err := list()
+		if err != nil {
+			return nil, err
+		}
+		i.byName = map[string][]T{}
+		for _, v := range all {
+			i.byName[nameOf(v)] = append(i.byName[nameOf(v)], v)
+		}
+	}
+	return i.byName[name], nil
+}
+
+// forget drops the index, so the next lookup lists everything again.
+func (i *nameIndex[T]) forget() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.byName = nil
+}
+
+// FindMonitorByName returns the monitor with the name, erroring when there
+// isn't exactly one match.
+func (c *Client) FindMonitorByName(ctx context.Context, name string) (*Monitor, error) {
+	found, err := c.monitorNames.lookup(name, func() ([]Monitor, error) {
+		return c.ListMonitors(ctx, ListMonitorsOptions{})
+	}, func(m Monitor) string { return m.Name })
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("%w: %s", ErrMonitorNotFound, name)
+	case 1:
+		return found[0].clone(), nil
+	default:
+		return nil, fmt.Errorf("found multiple monitors named %s", name)
+	}
+}
+
+// FindNotificationListByName returns the notification list with the name,
+// erroring when there isn't exactly one match.
+func (c *Client) FindNotificationListByName(ctx context.Context, name string) (*NotificationList, error) {
+	found, err := c.listNames.lookup(name, func() ([]NotificationList, error) {
+		return c.ListNotificationLists(ctx)
+	}, func(l NotificationList) string { return l.Name })
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("notification list %s: %w", name, ErrNotFound)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("found multiple notification lists named %s", name)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+)
+
+// RequestIDHeader is sent with every request, holding an id unique to it, so a
+// failed request can be found in cronitor's logs and those of any proxy.
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDsKey struct{}
+
+// requestIDs are the ids of the requests made during an operation.
+type requestIDs struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+// WithOperation returns a context for a single operation, such as creating a
+// resource. The requests made with it share a retry budget, and their ids are
+// kept for RequestIDs.
+func (c *Client) WithOperation(ctx context.Context) context.Context {
+	ctx = withRequestIDs(ctx)
+	if c != nil && c.operationBudget > 0 {
+		ctx = context.WithValue(ctx, operationBudgetKey{}, &retryBudget{name: "per operation", limit: c.operationBudget})
+	}
+	return ctx
+}
+
+// RequestIDs returns the ids of the requests made with the context, in the
+// order they were made. It is empty when the context isn't from WithOperation.
+func RequestIDs(ctx context.Context) []string {
+	r, ok := ctx.Value(requestIDsKey{}).(*requestIDs)
+	if !ok {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.ids...)
+}
+
+// newRequestID returns a random id for a request, recording it against the
+// operation when there is one.
+func newRequestID(ctx context.Context) string {
+	b := make([]byte, 16)
+	// The id is only for finding the request, so not worth failing it over
+	_, _ = rand.Read(b)
+	id := hex.EncodeToString(b)
+
+	recordRequestIDs(ctx, id)
+	return id
+}
+
+// withRequestIDs returns a context that keeps the ids of the requests made with
+// it apart from those of the operation ctx belongs to, so they can be recorded
+// against other operations too.
+func withRequestIDs(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestIDsKey{}, &requestIDs{})
+}
+
+// recordRequestIDs records the ids against the operation, when there is one.
+func recordRequestIDs(ctx context.Context, ids ...string) {
+	if r, ok := ctx.Value(requestIDsKey{}).(*requestIDs); ok {
+		r.mu.Lock()
+		r.ids = append(r.ids, ids...)
+		r.mu.Unlock()
+	}
+}
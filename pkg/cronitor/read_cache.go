@@ -0,0 +1,131 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	monitorsCacheKey          = "monitors"
+	notificationListsCacheKey = "notification_lists"
+)
+
+// readCache keeps the responses of reads for a while, so something referenced
+// by many data sources is only fetched once. Concurrent reads of the same thing
+// wait for the first one instead of each making a request.
+type readCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*readCacheEntry
+}
+
+type readCacheEntry struct {
+	done    chan struct{}
+	value   any
+	err     error
+	expires time.Time
+}
+
+func newReadCache(ttl time.Duration) *readCache {
+	return &readCache{ttl: ttl, entries: map[string]*readCacheEntry{}}
+}
+
+// cached returns the cached value for the key, calling fetch when there isn't
+// one or it has expired. Errors aren't cached.
+func cached[T any](c *readCache, key string, fetch func() (T, error)) (T, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if ok {
+		select {
+		case <-e.done:
+			if time.Now().After(e.expires) {
+				ok = false
+			}
+		default:
+		}
+	}
+	if !ok {
+		e = &readCacheEntry{done: make(chan struct{})}
+		c.entries[key] = e
+		c.mu.Unlock()
+
+		e.value, e.err = fetch()
+		e.expires = time.Now().Add(c.ttl)
+		close(e.done)
+		if e.err != nil {
+			c.mu.Lock()
+			if c.entries[key] == e {
+				delete(c.entries, key)
+			}
+			c.mu.Unlock()
+		}
+	} else {
+		c.mu.Unlock()
+		<-e.done
+	}
+
+	if e.err != nil {
+		var zero T
+		return zero, e.err
+	}
+	return e.value.(T), nil
+}
+
+// forget removes the keys, along with the filtered reads of them, for when the
+// things they hold have changed.
+func (c *readCache) forget(keys ...string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, k := range keys {
+		for entry := range c.entries {
+			if entry == k || strings.HasPrefix(entry, k+"?") {
+				delete(c.entries, entry)
+			}
+		}
+	}
+}
+
+func notificationListCacheKey(key string) string {
+	return "notification_list/" + key
+}
+
+// GetNotificationListCached is GetNotificationList, using the read cache when
+// it is enabled.
+func (c *Client) GetNotificationListCached(ctx context.Context, key string) (*NotificationList, error) {
+	return cached(c.reads, notificationListCacheKey(key), func() (*NotificationList, error) {
+		return c.GetNotificationList(ctx, key)
+	})
+}
+
+// ListNotificationListsCached is ListNotificationLists, using the read cache
+// when it is enabled.
+func (c *Client) ListNotificationListsCached(ctx context.Context) ([]NotificationList, error) {
+	return cached(c.reads, notificationListsCacheKey, func() ([]NotificationList, error) {
+		return c.ListNotificationLists(ctx)
+	})
+}
+
+// ListMonitorsCached is ListMonitors, using the read cache when it is enabled.
+func (c *Client) ListMonitorsCached(ctx context.Context, opts ListMonitorsOptions) ([]Monitor, error) {
+	key := monitorsCacheKey
+	if !opts.UpdatedSince.IsZero() {
+		key = fmt.Sprintf("%s?updated_since=%s", key, opts.UpdatedSince.Format(time.RFC3339Nano))
+	}
+	return cached(c.reads, key, func() ([]Monitor, error) {
+		return c.ListMonitors(ctx, opts)
+	})
+}
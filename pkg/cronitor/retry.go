@@ -0,0 +1,77 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	serverErrorRetries  = 3
+	serverErrorInterval = 500 * time.Millisecond
+)
+
+// DefaultRetryMethods are the methods retried when the api responds with a
+// server error. Only reads are retried, as retrying anything else could make
+// the same change twice when the first attempt went through.
+var DefaultRetryMethods = map[string]bool{
+	http.MethodGet:  true,
+	http.MethodHead: true,
+}
+
+// retryTransport retries requests the api responds to with a server error,
+// when their method is allowed to be retried.
+type retryTransport struct {
+	next        http.RoundTripper
+	methods     map[string]bool
+	waitToRetry func(ctx context.Context, d time.Duration) error
+}
+
+// newRetryTransport returns a retryTransport that retries the default methods,
+// with the overrides applied on top of them.
+func newRetryTransport(next http.RoundTripper, overrides map[string]bool) *retryTransport {
+	methods := map[string]bool{}
+	for m, retry := range DefaultRetryMethods {
+		methods[m] = retry
+	}
+	for m, retry := range overrides {
+		methods[strings.ToUpper(m)] = retry
+	}
+	return &retryTransport{next: next, methods: methods}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	delay := serverErrorInterval
+	for attempt := 1; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || resp.StatusCode < 500 || !t.methods[req.Method] || attempt == serverErrorRetries {
+			return resp, err
+		}
+		// The body has already been sent and can't be sent again
+		if req.Body != nil && req.GetBody == nil {
+			return resp, nil
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if err := t.waitToRetry(req.Context(), jitter(delay)); err != nil {
+			return nil, err
+		}
+		delay *= 2
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// retryBudget limits the total time spent waiting to retry requests, so an api
+// that keeps failing fails the run quickly instead of every resource waiting
+// out its own retries. A limit of 0 doesn't limit it.
+type retryBudget struct {
+	name  string
+	limit time.Duration
+
+	mu    sync.Mutex
+	spent time.Duration
+}
+
+// spend takes d from the budget, erroring instead when there isn't enough of it
+// left.
+func (b *retryBudget) spend(d time.Duration) error {
+	if b == nil || b.limit == 0 {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.spent+d > b.limit {
+		return fmt.Errorf("%w: already waited %s of the %s %s retry budget", ErrRetryBudgetExhausted, b.spent.Round(time.Millisecond), b.limit, b.name)
+	}
+	b.spent += d
+	return nil
+}
+
+type operationBudgetKey struct{}
+
+// WaitToRetry waits for d before something is retried, erroring instead when
+// that would go over the retry budget of the operation or the run.
+func (c *Client) WaitToRetry(ctx context.Context, d time.Duration) error {
+	if op, ok := ctx.Value(operationBudgetKey{}).(*retryBudget); ok {
+		if err := op.spend(d); err != nil {
+			return err
+		}
+	}
+	if err := c.runBudget.spend(d); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// failFirst responds with code to the first n requests, and 200 after that.
+func failFirst(n int32, code int, attempts *atomic.Int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) <= n {
+			w.WriteHeader(code)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}
+}
+
+func doRequest(t *testing.T, c *Client, ctx context.Context, method string) (int, error) {
+	t.Helper()
+	req, err := c.request(ctx, method, "/api/monitors", nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %s", err)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+func TestRetryServerErrorsByMethod(t *testing.T) {
+	tcs := []struct {
+		name      string
+		method    string
+		overrides map[string]bool
+		attempts  int32
+		code      int
+	}{
+		{name: "get is retried", method: http.MethodGet, attempts: 2, code: http.StatusOK},
+		{name: "post isn't retried", method: http.MethodPost, attempts: 1, code: http.StatusBadGateway},
+		{name: "post retried when allowed", method: http.MethodPost, overrides: map[string]bool{"post": true}, attempts: 2, code: http.StatusOK},
+		{name: "get not retried when disallowed", method: http.MethodGet, overrides: map[string]bool{http.MethodGet: false}, attempts: 1, code: http.StatusBadGateway},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			c := newTestClient(t, failFirst(1, http.StatusBadGateway, &attempts), NewClientOpts{RetryMethods: tc.overrides})
+
+			code, err := doRequest(t, c, context.Background(), tc.method)
+			if err != nil {
+				t.Fatalf("request failed: %s", err)
+			}
+			if code != tc.code {
+				t.Errorf("expected code %d, got %d", tc.code, code)
+			}
+			if got := attempts.Load(); got != tc.attempts {
+				t.Errorf("expected %d attempts, got %d", tc.attempts, got)
+			}
+		})
+	}
+}
+
+func TestRetryServerErrorsGivesUp(t *testing.T) {
+	var attempts atomic.Int32
+	c := newTestClient(t, failFirst(100, http.StatusServiceUnavailable, &attempts), NewClientOpts{})
+
+	code, err := doRequest(t, c, context.Background(), http.MethodGet)
+	if err != nil {
+		t.Fatalf("request failed: %s", err)
+	}
+	if code != http.StatusServiceUnavailable {
+		t.Errorf("expected the last server error to be returned, got %d", code)
+	}
+	if got := attempts.Load(); got != serverErrorRetries {
+		t.Errorf("expected %d attempts, got %d", serverErrorRetries, got)
+	}
+}
+
+func TestThrottleBacksOff(t *testing.T) {
+	var attempts atomic.Int32
+	c := newTestClient(t, failFirst(2, http.StatusTooManyRequests, &attempts), NewClientOpts{})
+
+	start := time.Now()
+	code, err := doRequest(t, c, context.Background(), http.MethodPost)
+	if err != nil {
+		t.Fatalf("request failed: %s", err)
+	}
+	if code != http.StatusOK {
+		t.Errorf("expected the request to succeed after backing off, got %d", code)
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("expected 3 attempts, got %d", got)
+	}
+	// Waits of 100ms then 200ms, less the most jitter can take off
+	if elapsed := time.Since(start); elapsed < 225*time.Millisecond {
+		t.Errorf("expected the retries to back off, took %s", elapsed)
+	}
+
+	stats, ok := c.ThrottleReport()
+	if !ok || stats.Throttled != 2 {
+		t.Errorf("expected 2 throttled requests to be reported, got %+v", stats)
+	}
+	if _, ok := c.ThrottleReport(); ok {
+		t.Error("expected nothing new to report")
+	}
+}
+
+func TestRetryBudgetPerOperation(t *testing.T) {
+	var attempts atomic.Int32
+	c := newTestClient(t, failFirst(100, http.StatusTooManyRequests, &attempts), NewClientOpts{OperationRetryBudget: 50 * time.Millisecond})
+
+	_, err := doRequest(t, c, c.WithOperation(context.Background()), http.MethodGet)
+	if !errors.Is(err, ErrRetryBudgetExhausted) {
+		t.Fatalf("expected the retry budget to run out, got %v", err)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("expected no retries once the budget ran out, got %d attempts", got)
+	}
+
+	// Requests outside an operation aren't limited by its budget
+	attempts.Store(99)
+	if _, err := doRequest(t, c, context.Background(), http.MethodGet); err != nil {
+		t.Errorf("expected the request outside an operation to be retried, got %s", err)
+	}
+}
+
+func TestRetryBudgetPerRun(t *testing.T) {
+	var attempts atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// Every other request is throttled
+		if attempts.Add(1)%2 == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}, NewClientOpts{RunRetryBudget: 150 * time.Millisecond})
+
+	if _, err := doRequest(t, c, c.WithOperation(context.Background()), http.MethodGet); err != nil {
+		t.Fatalf("expected the first retry to fit in the budget, got %s", err)
+	}
+	// The second wait is at least 150ms, which is more than is left
+	_, err := doRequest(t, c, c.WithOperation(context.Background()), http.MethodGet)
+	if !errors.Is(err, ErrRetryBudgetExhausted) {
+		t.Fatalf("expected the run's retry budget to run out across operations, got %v", err)
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"regexp"
+	"strings"
+)
+
+var slugSeparatorRegex = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// Slug converts a name into a key, lower casing it and replacing everything
+// other than letters, numbers and underscores with dashes.
+func Slug(name string) string {
+	return strings.Trim(slugSeparatorRegex.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
@@ -0,0 +1,156 @@
+// Copyright (c) Henry Whitaker
+// SPDX-License-Identifier: MIT
+
+package cronitor
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	throttleRetries     = 5
+	throttleMinInterval = 100 * time.Millisecond
+	throttleMaxInterval = 5 * time.Second
+)
+
+// throttledTransport retries requests the api rate limits, and spaces out every
+// request after that for the rest of the run. The gap doubles each time the api
+// rate limits again, so many requests in parallel slow down instead of failing.
+type throttledTransport struct {
+	next        http.RoundTripper
+	onThrottle  func(ctx context.Context, interval time.Duration)
+	waitToRetry func(ctx context.Context, d time.Duration) error
+
+	mu       sync.Mutex
+	interval time.Duration
+	nextSlot time.Time
+
+	throttled int
+	waited    time.Duration
+	reported  int
+}
+
+// ThrottleStats is how much the api has rate limited requests during the run.
+type ThrottleStats struct {
+	// Throttled is the number of responses that were rate limited
+	Throttled int
+	// Waited is the total time requests spent waiting because of it
+	Waited time.Duration
+}
+
+func newThrottledTransport(next http.RoundTripper, onThrottle func(context.Context, time.Duration)) *throttledTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &throttledTransport{next: next, onThrottle: onThrottle}
+}
+
+func (t *throttledTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if err := t.wait(req.Context()); err != nil {
+			return nil, err
+		}
+
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt == throttleRetries {
+			return resp, err
+		}
+		// The body has already been sent and can't be sent again
+		if req.Body != nil && req.GetBody == nil {
+			return resp, nil
+		}
+
+		delay := t.slowDown(req.Context())
+		if after, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && time.Duration(after)*time.Second > delay {
+			delay = time.Duration(after) * time.Second
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		delay = jitter(delay)
+		if err := t.waitToRetry(req.Context(), delay); err != nil {
+			return nil, err
+		}
+		t.addWait(delay)
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// slowDown doubles the gap between requests, returning the new gap.
+func (t *throttledTransport) slowDown(ctx context.Context) time.Duration {
+	t.mu.Lock()
+	t.throttled++
+	t.interval = min(max(t.interval*2, throttleMinInterval), throttleMaxInterval)
+	interval := t.interval
+	t.mu.Unlock()
+
+	if t.onThrottle != nil {
+		t.onThrottle(ctx, interval)
+	}
+	return interval
+}
+
+// wait blocks until the request's turn, when requests are being spaced out.
+func (t *throttledTransport) wait(ctx context.Context) error {
+	t.mu.Lock()
+	if t.interval == 0 {
+		t.mu.Unlock()
+		return nil
+	}
+	start := time.Now()
+	if t.nextSlot.After(start) {
+		start = t.nextSlot
+	}
+	t.nextSlot = start.Add(jitter(t.interval))
+	t.waited += time.Until(start)
+	t.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Until(start)):
+		return nil
+	}
+}
+
+func (t *throttledTransport) addWait(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.waited += d
+}
+
+// ThrottleReport returns how much the api has rate limited requests so far in
+// the run, only when it has rate limited more since the last report.
+func (c *Client) ThrottleReport() (ThrottleStats, bool) {
+	t := c.throttle
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.throttled == t.reported {
+		return ThrottleStats{}, false
+	}
+	t.reported = t.throttled
+	return ThrottleStats{Throttled: t.throttled, Waited: t.waited}, true
+}
+
+// jitter returns a random duration within a quarter either side of d, so
+// parallel requests that were throttled together don't retry together.
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return d
+	}
+	return d*3/4 + rand.N(d/2+1)
+}
@@ -2,6 +2,11 @@
 
 package cronitor
 
+import (
+	"maps"
+	"slices"
+)
+
 type Request struct {
 	URL             string            `json:"url"`
 	Headers         map[string]string `json:"headers,omitempty"`
@@ -11,7 +16,11 @@ type Request struct {
 	TimeoutSeconds  int               `json:"timeout_seconds"`
 	Regions         []string          `json:"regions,omitempty"`
 	FollowRedirects bool              `json:"follow_redirects"`
+	MaxRedirects    *int              `json:"max_redirects,omitempty"`
 	VerifySsl       bool              `json:"verify_ssl"`
+	ClientCert      string            `json:"client_certificate,omitempty"`
+	ClientKey       string            `json:"client_key,omitempty"`
+	IPVersion       string            `json:"ip_version,omitempty"`
 }
 
 type Monitor struct {
@@ -21,19 +30,77 @@ type Monitor struct {
 	FailureTolerance  *int     `json:"failure_tolerance,omitempty"`
 	GraceSeconds      *int     `json:"grace_seconds,omitempty"`
 	Group             *string  `json:"group,omitempty"`
+	Initialized       bool     `json:"initialized,omitempty"`
 	Key               *string  `json:"key,omitempty"`
-	Notify            []string `json:"notify"`
+	Note              *string  `json:"note,omitempty"`
+	Notify            []string `json:"notify,omitempty"`
+	Passing           bool     `json:"passing,omitempty"`
 	Paused            bool     `json:"paused"`
 	Platform          string   `json:"platform"`
-	RealertInterval   string   `json:"realert_interval"`
+	Position          *int     `json:"position,omitempty"`
+	RealertInterval   string   `json:"realert_interval,omitempty"`
 	Request           *Request `json:"request,omitempty"`
-	Running           bool     `json:"running"`
+	Running           bool     `json:"running,omitempty"`
 	Schedule          string   `json:"schedule"`
 	ScheduleTolerance *int     `json:"schedule_tolerance,omitempty"`
-	Tags              []string `json:"tags"`
+	Tags              []string `json:"tags,omitempty"`
 	Timezone          *string  `json:"timezone,omitempty"`
 	Type              string   `json:"type"`
-	Environments      []string `json:"environments"`
+	Environments      []string `json:"environments,omitempty"`
+	Updated           string   `json:"updated,omitempty"`
+
+	EnvironmentOverrides []EnvironmentOverride `json:"environment_overrides,omitempty"`
+	AlertRules           []AlertRule           `json:"alert_rules"`
+}
+
+// clone returns a copy of the monitor that shares no slices or maps with it, so
+// a cached monitor isn't changed by whoever it is handed to.
+func (m Monitor) clone() *Monitor {
+	out := m
+	out.Assertions = slices.Clone(m.Assertions)
+	out.Notify = slices.Clone(m.Notify)
+	out.Tags = slices.Clone(m.Tags)
+	out.Environments = slices.Clone(m.Environments)
+	if m.Request != nil {
+		req := *m.Request
+		req.Headers = maps.Clone(m.Request.Headers)
+		req.Cookies = maps.Clone(m.Request.Cookies)
+		req.Regions = slices.Clone(m.Request.Regions)
+		out.Request = &req
+	}
+	out.EnvironmentOverrides = slices.Clone(m.EnvironmentOverrides)
+	for i, o := range out.EnvironmentOverrides {
+		out.EnvironmentOverrides[i].Notify = slices.Clone(o.Notify)
+		out.EnvironmentOverrides[i].Assertions = slices.Clone(o.Assertions)
+	}
+	out.AlertRules = slices.Clone(m.AlertRules)
+	for i, r := range out.AlertRules {
+		out.AlertRules[i].Notify = slices.Clone(r.Notify)
+	}
+	return &out
+}
+
+// AlertRule escalates an alert to more notification lists once it has realerted
+// a number of times or been unresolved for a number of minutes.
+type AlertRule struct {
+	Notify       []string `json:"notify"`
+	AfterAlerts  *int     `json:"after_alerts,omitempty"`
+	AfterMinutes *int     `json:"after_minutes,omitempty"`
+}
+
+// EnvironmentOverride replaces the monitor's settings in a single environment.
+type EnvironmentOverride struct {
+	Environment string   `json:"environment"`
+	Schedule    *string  `json:"schedule,omitempty"`
+	Notify      []string `json:"notify,omitempty"`
+	Assertions  []string `json:"assertions,omitempty"`
+}
+
+type MonitorList struct {
+	Monitors   []Monitor `json:"monitors"`
+	Page       int       `json:"page"`
+	PageSize   int       `json:"page_size"`
+	TotalCount int       `json:"total_monitor_count"`
 }
 
 type Notifications struct {
@@ -42,10 +109,179 @@ type Notifications struct {
 	Pagerduty []string `json:"pagerduty,omitempty"`
 	Phones    []string `json:"phones,omitempty"`
 	Webhooks  []string `json:"webhook,omitempty"`
+
+	CustomWebhooks []Webhook  `json:"custom_webhook,omitempty"`
+	Sns            []SnsTopic `json:"sns,omitempty"`
+}
+
+// SnsTopic is an aws sns topic that notifications are published to.
+type SnsTopic struct {
+	TopicArn string `json:"topic_arn"`
+	Region   string `json:"region"`
+}
+
+// Webhook is a webhook that is sent with custom headers and payload.
+type Webhook struct {
+	Url     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Payload string            `json:"payload,omitempty"`
+}
+
+// NotificationEvents are the events that a notification list is alerted on.
+type NotificationEvents struct {
+	Failure      bool `json:"failure"`
+	Recovery     bool `json:"recovery"`
+	Warning      bool `json:"warning"`
+	WeeklyReport bool `json:"weekly_report"`
+}
+
+// QuietHours are the times that a notification list's channels are silenced.
+type QuietHours struct {
+	Timezone string        `json:"timezone"`
+	Windows  []QuietWindow `json:"windows"`
+	Channels []string      `json:"channels,omitempty"`
+}
+
+type QuietWindow struct {
+	Days  []string `json:"days"`
+	Start string   `json:"start"`
+	End   string   `json:"end"`
+}
+
+type NotificationListPage struct {
+	Templates  []NotificationList `json:"templates"`
+	Page       int                `json:"page"`
+	PageSize   int                `json:"page_size"`
+	TotalCount int                `json:"total_template_count"`
 }
 
 type NotificationList struct {
-	Name          string        `json:"name"`
-	Key           string        `json:"key"`
-	Notifications Notifications `json:"notifications,omitempty"`
+	Name          string              `json:"name"`
+	Key           string              `json:"key"`
+	Notifications Notifications       `json:"notifications,omitempty"`
+	Events        *NotificationEvents `json:"events,omitempty"`
+	QuietHours    *QuietHours         `json:"quiet_hours,omitempty"`
+	IsDefault     bool                `json:"is_default"`
+	Monitors      []string            `json:"monitors,omitempty"`
+}
+
+type Group struct {
+	Key      string         `json:"key,omitempty"`
+	Name     string         `json:"name"`
+	Monitors []string       `json:"monitors,omitempty"`
+	Defaults *GroupDefaults `json:"defaults,omitempty"`
+}
+
+// GroupDefaults are the settings used by monitors in the group that don't set
+// their own.
+type GroupDefaults struct {
+	Notify          []string `json:"notify,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	RealertInterval string   `json:"realert_interval,omitempty"`
+}
+
+type StatusPage struct {
+	Key          string  `json:"key,omitempty"`
+	Name         string  `json:"name"`
+	Subdomain    string  `json:"hosted_subdomain"`
+	CustomDomain *string `json:"custom_domain,omitempty"`
+	LogoUrl      string  `json:"logo_url"`
+	FaviconUrl   string  `json:"favicon_url"`
+	BrandColor   string  `json:"brand_color"`
+	Theme        string  `json:"theme,omitempty"`
+
+	Sections []StatusPageSection `json:"sections"`
+
+	CustomDomainTarget string `json:"custom_domain_target,omitempty"`
+	TLSStatus          string `json:"tls_status,omitempty"`
+}
+
+type StatusPageList struct {
+	StatusPages []StatusPage `json:"statuspages"`
+	Page        int          `json:"page"`
+	PageSize    int          `json:"page_size"`
+	TotalCount  int          `json:"total_statuspage_count"`
+}
+
+// StatusPageSection is a named group of monitors on a status page, in the
+// order they are shown.
+type StatusPageSection struct {
+	Name     string   `json:"name"`
+	Monitors []string `json:"monitors"`
+}
+
+// StatusPageIncident is an incident or planned maintenance published on a
+// status page.
+type StatusPageIncident struct {
+	ID         string                     `json:"id,omitempty"`
+	Title      string                     `json:"title"`
+	Status     string                     `json:"status"`
+	Components []string                   `json:"components"`
+	Updates    []StatusPageIncidentUpdate `json:"updates"`
+}
+
+// StatusPageIncidentUpdate is a message posted to an incident, in the order
+// they were posted.
+type StatusPageIncidentUpdate struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// StatusPageSubscriber is notified of changes to a status page.
+type StatusPageSubscriber struct {
+	ID    string `json:"id,omitempty"`
+	Type  string `json:"type"`
+	Email string `json:"email,omitempty"`
+	Url   string `json:"url,omitempty"`
+}
+
+// Issue is an incident opened against one or more monitors.
+type Issue struct {
+	Key         string   `json:"key,omitempty"`
+	Name        string   `json:"name"`
+	Body        string   `json:"body,omitempty"`
+	State       string   `json:"state,omitempty"`
+	Severity    string   `json:"severity"`
+	Monitors    []string `json:"monitors"`
+	AutoResolve bool     `json:"auto_resolve"`
+}
+
+type alertActionRequest struct {
+	Note string `json:"note,omitempty"`
+}
+
+type testAlertRequest struct {
+	Monitor string `json:"monitor,omitempty"`
+}
+
+// AccountSettings are the alerting defaults for the whole account. Empty values
+// are left unchanged when updating.
+type AccountSettings struct {
+	DefaultRealertInterval  string   `json:"default_realert_interval,omitempty"`
+	DefaultNotificationList string   `json:"default_notification_list,omitempty"`
+	WeeklyReportRecipients  []string `json:"weekly_report_recipients,omitempty"`
+}
+
+// WebhookSigningSecret is the secret used to sign the webhook alerts sent by
+// cronitor.
+type WebhookSigningSecret struct {
+	Secret    string `json:"secret,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+// PingOptions are the optional parameters sent with a telemetry ping.
+type PingOptions struct {
+	State   string
+	Env     string
+	Message string
+	Series  string
+	Metrics PingMetrics
+}
+
+// PingMetrics are the metrics recorded with a telemetry ping, nil values aren't
+// sent.
+type PingMetrics struct {
+	Count      *int64
+	Duration   *float64
+	ErrorCount *int64
 }